@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -21,6 +22,86 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
+// Defines values for EdgeCaseName.
+const (
+	EdgeCaseNameCheckDigits02 EdgeCaseName = "checkDigits02"
+
+	EdgeCaseNameCheckDigits98 EdgeCaseName = "checkDigits98"
+
+	EdgeCaseNameLeadingZeroBankCode EdgeCaseName = "leadingZeroBankCode"
+
+	EdgeCaseNameLetterHeavy EdgeCaseName = "letterHeavy"
+
+	EdgeCaseNameLongest EdgeCaseName = "longest"
+
+	EdgeCaseNameNineAccountNo EdgeCaseName = "nineAccountNo"
+
+	EdgeCaseNameZeroAccountNo EdgeCaseName = "zeroAccountNo"
+)
+
+// Defines values for IBANGenerationDefect.
+const (
+	IBANGenerationDefectBankCode IBANGenerationDefect = "bankCode"
+
+	IBANGenerationDefectCheckDigits IBANGenerationDefect = "checkDigits"
+
+	IBANGenerationDefectCountry IBANGenerationDefect = "country"
+
+	IBANGenerationDefectLength IBANGenerationDefect = "length"
+
+	IBANGenerationDefectLetters IBANGenerationDefect = "letters"
+
+	IBANGenerationDefectNationalCheckDigit IBANGenerationDefect = "nationalCheckDigit"
+)
+
+// Defines values for IBANValidationReason.
+const (
+	IBANValidationReasonIllegalCharacters IBANValidationReason = "illegalCharacters"
+
+	IBANValidationReasonInvalidBBAN IBANValidationReason = "invalidBBAN"
+
+	IBANValidationReasonInvalidChecksum IBANValidationReason = "invalidChecksum"
+
+	IBANValidationReasonInvalidLength IBANValidationReason = "invalidLength"
+
+	IBANValidationReasonNationalCheckDigit IBANValidationReason = "nationalCheckDigit"
+
+	IBANValidationReasonUnknownCountry IBANValidationReason = "unknownCountry"
+)
+
+// Defines values for KontoCheckReason.
+const (
+	KontoCheckReasonCheckDigit KontoCheckReason = "checkDigit"
+
+	KontoCheckReasonMalformedAccountNo KontoCheckReason = "malformedAccountNo"
+
+	KontoCheckReasonUnknownBankCode KontoCheckReason = "unknownBankCode"
+
+	KontoCheckReasonUnsupportedMethod KontoCheckReason = "unsupportedMethod"
+)
+
+// Defines values for SuggestionKind.
+const (
+	SuggestionKindSubstitution SuggestionKind = "substitution"
+
+	SuggestionKindTransposition SuggestionKind = "transposition"
+)
+
+// Defines values for TypoKind.
+const (
+	TypoKindDeletion TypoKind = "deletion"
+
+	TypoKindDuplication TypoKind = "duplication"
+
+	TypoKindLookalike TypoKind = "lookalike"
+
+	TypoKindLowercase TypoKind = "lowercase"
+
+	TypoKindSubstitution TypoKind = "substitution"
+
+	TypoKindTransposition TypoKind = "transposition"
+)
+
 // The details BIC.
 type BIC struct {
 	Bank        string `json:"bank"`
@@ -28,18 +109,171 @@ type BIC struct {
 	CountryCode string `json:"countryCode"`
 }
 
+// A valid iban at a boundary of the iban structure of a country.
+type EdgeCase struct {
+	Bankcode    string  `json:"bankcode"`
+	Bic         *string `json:"bic,omitempty"`
+	Description string  `json:"description"`
+	Iban        string  `json:"iban"`
+
+	// The kind of edge case.
+	Name EdgeCaseName `json:"name"`
+}
+
+// The kind of edge case.
+type EdgeCaseName string
+
 // An error response.
 type Error struct {
 	Error string `json:"error"`
 }
 
-// The details of a generated iban.
-type IBANGeneration struct {
+// The iban of a converted account.
+type IBANConversion struct {
+	// The account number of the iban, which can differ from the legacy account number.
+	AccountNo string `json:"accountNo"`
+
+	// The bank code of the iban, which can differ from the legacy bank code.
 	Bankcode string  `json:"bankcode"`
 	Bic      *string `json:"bic,omitempty"`
 	Iban     string  `json:"iban"`
+
+	// The IBAN rule that was applied.
+	Rule string `json:"rule"`
+}
+
+// Representations of an iban.
+type IBANFormats struct {
+	// The compact form without spaces.
+	Electronic *string `json:"electronic,omitempty"`
+
+	// The print form with all but the country code, the check digits and the last four characters masked.
+	Masked *string `json:"masked,omitempty"`
+
+	// The paper form in groups of four.
+	Print *string `json:"print,omitempty"`
+
+	// The iban URI.
+	Uri *string `json:"uri,omitempty"`
+}
+
+// The details of a generated iban.
+type IBANGeneration struct {
+	// The bank code of the iban. For an invalid iban it consists of the characters at the positions of the bank code, so it contains the defect if the defect changed the bank code.
+	Bankcode string `json:"bankcode"`
+
+	// The normalized BIC that was used for generation.
+	Bic *string `json:"bic,omitempty"`
+
+	// The defect of an invalid iban.
+	Defect *IBANGenerationDefect `json:"defect,omitempty"`
+
+	// Representations of an iban.
+	Formats *IBANFormats `json:"formats,omitempty"`
+	Iban    string       `json:"iban"`
+
+	// A QR reference for Swiss QR-bills.
+	QrReference *string `json:"qrReference,omitempty"`
+
+	// An opaque token that reproduces the response when it is passed as the replay parameter, as long as the bank data, the sort code table and the version of iban-gen are unchanged.
+	ReplayToken string `json:"replayToken"`
+}
+
+// The defect of an invalid iban.
+type IBANGenerationDefect string
+
+// A page of enumerated ibans.
+type IBANPage struct {
+	// An opaque cursor for the next page. It is missing on the last page.
+	Cursor *string  `json:"cursor,omitempty"`
+	Ibans  []string `json:"ibans"`
+}
+
+// The result of an iban validation.
+type IBANValidation struct {
+	Bank        *string `json:"bank,omitempty"`
+	Bankcode    *string `json:"bankcode,omitempty"`
+	Bic         *string `json:"bic,omitempty"`
+	CountryCode *string `json:"countryCode,omitempty"`
+
+	// A human-readable description of the failure.
+	Error *string `json:"error,omitempty"`
+	Iban  string  `json:"iban"`
+
+	// The iban without whitespace, separators and prefix in upper case as it was validated.
+	Normalized string `json:"normalized"`
+
+	// The machine-readable reason why the iban is invalid.
+	Reason *IBANValidationReason `json:"reason,omitempty"`
+
+	// Valid ibans that differ from an invalid iban by a single substituted character or transposition of adjacent characters. Ibans of known banks come first.
+	Suggestions *[]Suggestion `json:"suggestions,omitempty"`
+	Valid       bool          `json:"valid"`
+}
+
+// The machine-readable reason why the iban is invalid.
+type IBANValidationReason string
+
+// The result of an account number check.
+type KontoCheck struct {
+	AccountNo string `json:"accountNo"`
+	Bankcode  string `json:"bankcode"`
+
+	// A human-readable description of the failure.
+	Error *string `json:"error,omitempty"`
+
+	// The check method that was applied.
+	Method *string `json:"method,omitempty"`
+
+	// The machine-readable reason why the check failed.
+	Reason *KontoCheckReason `json:"reason,omitempty"`
+	Valid  bool              `json:"valid"`
+}
+
+// The machine-readable reason why the check failed.
+type KontoCheckReason string
+
+// A valid iban that might have been intended.
+type Suggestion struct {
+	// The name of the bank if the bank code is known.
+	Bank *string `json:"bank,omitempty"`
+	Iban string  `json:"iban"`
+
+	// The corrected mistake.
+	Kind SuggestionKind `json:"kind"`
+
+	// The index of the first corrected character.
+	Position int `json:"position"`
+}
+
+// The corrected mistake.
+type SuggestionKind string
+
+// A variant of an iban with a typing mistake.
+type Typo struct {
+	// Whether the mod-97 checksum catches the mistake.
+	Caught bool `json:"caught"`
+
+	// The kind of mistake.
+	Kind TypoKind `json:"kind"`
+
+	// The index of the first changed character.
+	Position int    `json:"position"`
+	Variant  string `json:"variant"`
 }
 
+// The kind of mistake.
+type TypoKind string
+
+// Format defines model for Format.
+type Format []string
+
+// Replay defines model for Replay.
+type Replay string
+
+// Seed defines model for Seed.
+type Seed int64
+
 // An error response.
 type ErrorResponse Error
 
@@ -52,9 +286,96 @@ type BicsParams struct {
 	Bank *string `json:"bank,omitempty"`
 }
 
+// ConvertParams defines parameters for Convert.
+type ConvertParams struct {
+	// The German bank code.
+	BankCode string `json:"bankCode"`
+
+	// The account number.
+	AccountNo string `json:"accountNo"`
+}
+
+// EdgecasesParams defines parameters for Edgecases.
+type EdgecasesParams struct {
+	// The country code to use.
+	CountryCode string `json:"countryCode"`
+}
+
+// EnumerateParams defines parameters for Enumerate.
+type EnumerateParams struct {
+	// The BIC of the bank. Case, whitespace and a missing XXX branch code are ignored.
+	Bic *string `json:"bic,omitempty"`
+
+	// The bank code of the bank, if no bic is given.
+	BankCode *string `json:"bankCode,omitempty"`
+
+	// The country code of the bank code. Defaults to DE.
+	CountryCode *string `json:"countryCode,omitempty"`
+
+	// Skip German account numbers that fail the check method of the bank.
+	SkipInvalid *bool `json:"skipInvalid,omitempty"`
+
+	// The maximum number of ibans of a page. Defaults to 100.
+	Limit *int `json:"limit,omitempty"`
+
+	// The cursor of the previous page. All other parameters except limit are taken from the cursor.
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// KontocheckParams defines parameters for Kontocheck.
+type KontocheckParams struct {
+	// The German bank code.
+	BankCode string `json:"bankCode"`
+
+	// The account number.
+	AccountNo string `json:"accountNo"`
+}
+
 // RandomParams defines parameters for Random.
 type RandomParams struct {
-	// The BIC to use for generation.
+	// The BIC to use for generation. Case, whitespace and a missing XXX branch code are ignored.
+	Bic *string `json:"bic,omitempty"`
+
+	// The bank code to use for generation.
+	BankCode *string `json:"bankCode,omitempty"`
+
+	// The country code to use.
+	CountryCode *string `json:"countryCode,omitempty"`
+
+	// Generate a Swiss QR-IBAN if true or a regular IBAN if false. Only supported for the country codes CH and LI. Defaults to CH.
+	QrIban *bool `json:"qrIban,omitempty"`
+
+	// Also generate a QR reference for Swiss QR-bills.
+	QrReference *bool `json:"qrReference,omitempty"`
+
+	// Generate an iban that matches the template. Spaces are ignored, * matches any allowed character and [lo-hi] matches the numbers from lo to hi with the number of digits of lo. Shorter templates are padded with wildcards. Check digits are computed. All other generation parameters except qrReference and invalid are ignored. Fails with 422 if no valid iban matches the template and with 503 if the search for a large template reached its limit before it found a valid iban.
+	Template *string `json:"template,omitempty"`
+
+	// Generate an invalid iban with the given defect. The defect bankCode generates a bank code that is unknown to the bank data. The defect nationalCheckDigit breaks the national check digits of the BBAN or, for German ibans, the check digit of the account number, which requires a bic or a bank code.
+	Invalid *RandomParamsInvalid `json:"invalid,omitempty"`
+
+	// Return the iban in these representations. all returns every representation.
+	Format *Format `json:"format,omitempty"`
+
+	// The seed for the generation. Requests with the same seed and parameters return the same result. A random seed is used if omitted.
+	Seed *Seed `json:"seed,omitempty"`
+
+	// A replay token of a previous response. The request is repeated with the seed and parameters of the token and all other parameters are ignored.
+	Replay *Replay `json:"replay,omitempty"`
+}
+
+// RandomParamsInvalid defines parameters for Random.
+type RandomParamsInvalid string
+
+// RandomParamsFormat defines parameters for Random.
+type RandomParamsFormat string
+
+// RandomBatchParams defines parameters for RandomBatch.
+type RandomBatchParams struct {
+	// The number of ibans to generate. At most 1000 for JSON arrays and 1000000 for NDJSON streams.
+	Count int `json:"count"`
+
+	// The BIC to use for generation. Case, whitespace and a missing XXX branch code are ignored.
 	Bic *string `json:"bic,omitempty"`
 
 	// The bank code to use for generation.
@@ -62,8 +383,47 @@ type RandomParams struct {
 
 	// The country code to use.
 	CountryCode *string `json:"countryCode,omitempty"`
+
+	// Generate Swiss QR-IBANs if true or regular IBANs if false. Only supported for the country codes CH and LI. Defaults to CH.
+	QrIban *bool `json:"qrIban,omitempty"`
+
+	// Also generate a QR reference for Swiss QR-bills.
+	QrReference *bool `json:"qrReference,omitempty"`
+
+	// Return the iban in these representations. all returns every representation.
+	Format *Format `json:"format,omitempty"`
+
+	// The seed for the generation. Requests with the same seed and parameters return the same result. A random seed is used if omitted.
+	Seed *Seed `json:"seed,omitempty"`
+}
+
+// RandomBatchParamsFormat defines parameters for RandomBatch.
+type RandomBatchParamsFormat string
+
+// TyposParams defines parameters for Typos.
+type TyposParams struct {
+	// The valid iban to derive the variants from. Case, whitespace, separators and an "IBAN" prefix are ignored.
+	Iban string `json:"iban"`
+
+	// Return only variants with this kind of mistake.
+	Kind *TyposParamsKind `json:"kind,omitempty"`
+}
+
+// TyposParamsKind defines parameters for Typos.
+type TyposParamsKind string
+
+// ValidateParams defines parameters for Validate.
+type ValidateParams struct {
+	// The iban to validate. Case, whitespace, separators and an "IBAN" prefix are ignored.
+	Iban string `json:"iban"`
 }
 
+// ValidateBatchJSONBody defines parameters for ValidateBatch.
+type ValidateBatchJSONBody []string
+
+// ValidateBatchJSONRequestBody defines body for ValidateBatch for application/json ContentType.
+type ValidateBatchJSONRequestBody ValidateBatchJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -140,11 +500,37 @@ type ClientInterface interface {
 	// Bics request
 	Bics(ctx context.Context, params *BicsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Convert request
+	Convert(ctx context.Context, params *ConvertParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CountryCodes request
 	CountryCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// Edgecases request
+	Edgecases(ctx context.Context, params *EdgecasesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Enumerate request
+	Enumerate(ctx context.Context, params *EnumerateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Kontocheck request
+	Kontocheck(ctx context.Context, params *KontocheckParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Random request
 	Random(ctx context.Context, params *RandomParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RandomBatch request
+	RandomBatch(ctx context.Context, params *RandomBatchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Typos request
+	Typos(ctx context.Context, params *TyposParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Validate request
+	Validate(ctx context.Context, params *ValidateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateBatch request with any body
+	ValidateBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateBatch(ctx context.Context, body ValidateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) Bics(ctx context.Context, params *BicsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -159,6 +545,18 @@ func (c *Client) Bics(ctx context.Context, params *BicsParams, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
+func (c *Client) Convert(ctx context.Context, params *ConvertParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) CountryCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewCountryCodesRequest(c.Server)
 	if err != nil {
@@ -171,6 +569,42 @@ func (c *Client) CountryCodes(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
+func (c *Client) Edgecases(ctx context.Context, params *EdgecasesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEdgecasesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Enumerate(ctx context.Context, params *EnumerateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEnumerateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Kontocheck(ctx context.Context, params *KontocheckParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewKontocheckRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) Random(ctx context.Context, params *RandomParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewRandomRequest(c.Server, params)
 	if err != nil {
@@ -183,6 +617,66 @@ func (c *Client) Random(ctx context.Context, params *RandomParams, reqEditors ..
 	return c.Client.Do(req)
 }
 
+func (c *Client) RandomBatch(ctx context.Context, params *RandomBatchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRandomBatchRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Typos(ctx context.Context, params *TyposParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTyposRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Validate(ctx context.Context, params *ValidateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateBatchRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateBatch(ctx context.Context, body ValidateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateBatchRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewBicsRequest generates requests for Bics
 func NewBicsRequest(server string, params *BicsParams) (*http.Request, error) {
 	var err error
@@ -246,8 +740,8 @@ func NewBicsRequest(server string, params *BicsParams) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCountryCodesRequest generates requests for CountryCodes
-func NewCountryCodesRequest(server string) (*http.Request, error) {
+// NewConvertRequest generates requests for Convert
+func NewConvertRequest(server string, params *ConvertParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -255,7 +749,7 @@ func NewCountryCodesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/countryCodes")
+	operationPath := fmt.Sprintf("/v1/convert")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -265,16 +759,44 @@ func NewCountryCodesRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bankCode", runtime.ParamLocationQuery, params.BankCode); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
 
-	return req, nil
-}
-
-// NewRandomRequest generates requests for Random
-func NewRandomRequest(server string, params *RandomParams) (*http.Request, error) {
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "accountNo", runtime.ParamLocationQuery, params.AccountNo); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCountryCodesRequest generates requests for CountryCodes
+func NewCountryCodesRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -282,7 +804,77 @@ func NewRandomRequest(server string, params *RandomParams) (*http.Request, error
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/random")
+	operationPath := fmt.Sprintf("/v1/countryCodes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEdgecasesRequest generates requests for Edgecases
+func NewEdgecasesRequest(server string, params *EdgecasesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/edgecases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "countryCode", runtime.ParamLocationQuery, params.CountryCode); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEnumerateRequest generates requests for Enumerate
+func NewEnumerateRequest(server string, params *EnumerateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/enumerate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -342,294 +934,1940 @@ func NewRandomRequest(server string, params *RandomParams) (*http.Request, error
 
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	if params.SkipInvalid != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skipInvalid", runtime.ParamLocationQuery, *params.SkipInvalid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
-}
+	if params.Limit != nil {
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	if params.Cursor != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
 		}
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// Bics request
-	BicsWithResponse(ctx context.Context, params *BicsParams, reqEditors ...RequestEditorFn) (*BicsResponse, error)
+// NewKontocheckRequest generates requests for Kontocheck
+func NewKontocheckRequest(server string, params *KontocheckParams) (*http.Request, error) {
+	var err error
 
-	// CountryCodes request
-	CountryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CountryCodesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// Random request
-	RandomWithResponse(ctx context.Context, params *RandomParams, reqEditors ...RequestEditorFn) (*RandomResponse, error)
-}
+	operationPath := fmt.Sprintf("/v1/kontocheck")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-type BicsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]BIC
-	JSONDefault  *Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r BicsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bankCode", runtime.ParamLocationQuery, params.BankCode); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r BicsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "accountNo", runtime.ParamLocationQuery, params.AccountNo); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
 	}
-	return 0
-}
 
-type CountryCodesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]string
-	JSONDefault  *Error
-}
+	queryURL.RawQuery = queryValues.Encode()
 
-// Status returns HTTPResponse.Status
-func (r CountryCodesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CountryCodesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return req, nil
 }
 
-type RandomResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IBANGeneration
-	JSONDefault  *Error
-}
+// NewRandomRequest generates requests for Random
+func NewRandomRequest(server string, params *RandomParams) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r RandomResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RandomResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/v1/random")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// BicsWithResponse request returning *BicsResponse
-func (c *ClientWithResponses) BicsWithResponse(ctx context.Context, params *BicsParams, reqEditors ...RequestEditorFn) (*BicsResponse, error) {
-	rsp, err := c.Bics(ctx, params, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseBicsResponse(rsp)
-}
 
-// CountryCodesWithResponse request returning *CountryCodesResponse
-func (c *ClientWithResponses) CountryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CountryCodesResponse, error) {
-	rsp, err := c.CountryCodes(ctx, reqEditors...)
+	queryValues := queryURL.Query()
+
+	if params.Bic != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bic", runtime.ParamLocationQuery, *params.Bic); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.BankCode != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bankCode", runtime.ParamLocationQuery, *params.BankCode); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.CountryCode != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "countryCode", runtime.ParamLocationQuery, *params.CountryCode); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QrIban != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrIban", runtime.ParamLocationQuery, *params.QrIban); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QrReference != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrReference", runtime.ParamLocationQuery, *params.QrReference); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Template != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "template", runtime.ParamLocationQuery, *params.Template); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Invalid != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "invalid", runtime.ParamLocationQuery, *params.Invalid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Seed != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "seed", runtime.ParamLocationQuery, *params.Seed); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Replay != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "replay", runtime.ParamLocationQuery, *params.Replay); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRandomBatchRequest generates requests for RandomBatch
+func NewRandomBatchRequest(server string, params *RandomBatchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/randomBatch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "count", runtime.ParamLocationQuery, params.Count); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Bic != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bic", runtime.ParamLocationQuery, *params.Bic); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.BankCode != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bankCode", runtime.ParamLocationQuery, *params.BankCode); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.CountryCode != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "countryCode", runtime.ParamLocationQuery, *params.CountryCode); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QrIban != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrIban", runtime.ParamLocationQuery, *params.QrIban); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.QrReference != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "qrReference", runtime.ParamLocationQuery, *params.QrReference); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Format != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Seed != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "seed", runtime.ParamLocationQuery, *params.Seed); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTyposRequest generates requests for Typos
+func NewTyposRequest(server string, params *TyposParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/typos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "iban", runtime.ParamLocationQuery, params.Iban); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	if params.Kind != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "kind", runtime.ParamLocationQuery, *params.Kind); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewValidateRequest generates requests for Validate
+func NewValidateRequest(server string, params *ValidateParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if queryFrag, err := runtime.StyleParamWithLocation("form", true, "iban", runtime.ParamLocationQuery, params.Iban); err != nil {
+		return nil, err
+	} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		return nil, err
+	} else {
+		for k, v := range parsed {
+			for _, v2 := range v {
+				queryValues.Add(k, v2)
+			}
+		}
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewValidateBatchRequest calls the generic ValidateBatch builder with application/json body
+func NewValidateBatchRequest(server string, body ValidateBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewValidateBatchRequestWithBody generates requests for ValidateBatch with any type of body
+func NewValidateBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// Bics request
+	BicsWithResponse(ctx context.Context, params *BicsParams, reqEditors ...RequestEditorFn) (*BicsResponse, error)
+
+	// Convert request
+	ConvertWithResponse(ctx context.Context, params *ConvertParams, reqEditors ...RequestEditorFn) (*ConvertResponse, error)
+
+	// CountryCodes request
+	CountryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CountryCodesResponse, error)
+
+	// Edgecases request
+	EdgecasesWithResponse(ctx context.Context, params *EdgecasesParams, reqEditors ...RequestEditorFn) (*EdgecasesResponse, error)
+
+	// Enumerate request
+	EnumerateWithResponse(ctx context.Context, params *EnumerateParams, reqEditors ...RequestEditorFn) (*EnumerateResponse, error)
+
+	// Kontocheck request
+	KontocheckWithResponse(ctx context.Context, params *KontocheckParams, reqEditors ...RequestEditorFn) (*KontocheckResponse, error)
+
+	// Random request
+	RandomWithResponse(ctx context.Context, params *RandomParams, reqEditors ...RequestEditorFn) (*RandomResponse, error)
+
+	// RandomBatch request
+	RandomBatchWithResponse(ctx context.Context, params *RandomBatchParams, reqEditors ...RequestEditorFn) (*RandomBatchResponse, error)
+
+	// Typos request
+	TyposWithResponse(ctx context.Context, params *TyposParams, reqEditors ...RequestEditorFn) (*TyposResponse, error)
+
+	// Validate request
+	ValidateWithResponse(ctx context.Context, params *ValidateParams, reqEditors ...RequestEditorFn) (*ValidateResponse, error)
+
+	// ValidateBatch request with any body
+	ValidateBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateBatchResponse, error)
+
+	ValidateBatchWithResponse(ctx context.Context, body ValidateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateBatchResponse, error)
+}
+
+type BicsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BIC
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r BicsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BicsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ConvertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IBANConversion
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ConvertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CountryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r CountryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CountryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EdgecasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]EdgeCase
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EdgecasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EdgecasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EnumerateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IBANPage
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r EnumerateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EnumerateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type KontocheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *KontoCheck
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r KontocheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r KontocheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RandomResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IBANGeneration
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RandomResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RandomResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RandomBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IBANGeneration
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RandomBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RandomBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TyposResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Typo
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r TyposResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TyposResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IBANValidation
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ValidateBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]IBANValidation
+	JSONDefault  *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ValidateBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ValidateBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// BicsWithResponse request returning *BicsResponse
+func (c *ClientWithResponses) BicsWithResponse(ctx context.Context, params *BicsParams, reqEditors ...RequestEditorFn) (*BicsResponse, error) {
+	rsp, err := c.Bics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBicsResponse(rsp)
+}
+
+// ConvertWithResponse request returning *ConvertResponse
+func (c *ClientWithResponses) ConvertWithResponse(ctx context.Context, params *ConvertParams, reqEditors ...RequestEditorFn) (*ConvertResponse, error) {
+	rsp, err := c.Convert(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertResponse(rsp)
+}
+
+// CountryCodesWithResponse request returning *CountryCodesResponse
+func (c *ClientWithResponses) CountryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CountryCodesResponse, error) {
+	rsp, err := c.CountryCodes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCountryCodesResponse(rsp)
+}
+
+// EdgecasesWithResponse request returning *EdgecasesResponse
+func (c *ClientWithResponses) EdgecasesWithResponse(ctx context.Context, params *EdgecasesParams, reqEditors ...RequestEditorFn) (*EdgecasesResponse, error) {
+	rsp, err := c.Edgecases(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEdgecasesResponse(rsp)
+}
+
+// EnumerateWithResponse request returning *EnumerateResponse
+func (c *ClientWithResponses) EnumerateWithResponse(ctx context.Context, params *EnumerateParams, reqEditors ...RequestEditorFn) (*EnumerateResponse, error) {
+	rsp, err := c.Enumerate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEnumerateResponse(rsp)
+}
+
+// KontocheckWithResponse request returning *KontocheckResponse
+func (c *ClientWithResponses) KontocheckWithResponse(ctx context.Context, params *KontocheckParams, reqEditors ...RequestEditorFn) (*KontocheckResponse, error) {
+	rsp, err := c.Kontocheck(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKontocheckResponse(rsp)
+}
+
+// RandomWithResponse request returning *RandomResponse
+func (c *ClientWithResponses) RandomWithResponse(ctx context.Context, params *RandomParams, reqEditors ...RequestEditorFn) (*RandomResponse, error) {
+	rsp, err := c.Random(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRandomResponse(rsp)
+}
+
+// RandomBatchWithResponse request returning *RandomBatchResponse
+func (c *ClientWithResponses) RandomBatchWithResponse(ctx context.Context, params *RandomBatchParams, reqEditors ...RequestEditorFn) (*RandomBatchResponse, error) {
+	rsp, err := c.RandomBatch(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRandomBatchResponse(rsp)
+}
+
+// TyposWithResponse request returning *TyposResponse
+func (c *ClientWithResponses) TyposWithResponse(ctx context.Context, params *TyposParams, reqEditors ...RequestEditorFn) (*TyposResponse, error) {
+	rsp, err := c.Typos(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTyposResponse(rsp)
+}
+
+// ValidateWithResponse request returning *ValidateResponse
+func (c *ClientWithResponses) ValidateWithResponse(ctx context.Context, params *ValidateParams, reqEditors ...RequestEditorFn) (*ValidateResponse, error) {
+	rsp, err := c.Validate(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateResponse(rsp)
+}
+
+// ValidateBatchWithBodyWithResponse request with arbitrary body returning *ValidateBatchResponse
+func (c *ClientWithResponses) ValidateBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateBatchResponse, error) {
+	rsp, err := c.ValidateBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) ValidateBatchWithResponse(ctx context.Context, body ValidateBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateBatchResponse, error) {
+	rsp, err := c.ValidateBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValidateBatchResponse(rsp)
+}
+
+// ParseBicsResponse parses an HTTP response from a BicsWithResponse call
+func ParseBicsResponse(rsp *http.Response) (*BicsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BicsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BIC
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseConvertResponse parses an HTTP response from a ConvertWithResponse call
+func ParseConvertResponse(rsp *http.Response) (*ConvertResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConvertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IBANConversion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCountryCodesResponse parses an HTTP response from a CountryCodesWithResponse call
+func ParseCountryCodesResponse(rsp *http.Response) (*CountryCodesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CountryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEdgecasesResponse parses an HTTP response from a EdgecasesWithResponse call
+func ParseEdgecasesResponse(rsp *http.Response) (*EdgecasesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EdgecasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []EdgeCase
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEnumerateResponse parses an HTTP response from a EnumerateWithResponse call
+func ParseEnumerateResponse(rsp *http.Response) (*EnumerateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EnumerateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IBANPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseKontocheckResponse parses an HTTP response from a KontocheckWithResponse call
+func ParseKontocheckResponse(rsp *http.Response) (*KontocheckResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &KontocheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest KontoCheck
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRandomResponse parses an HTTP response from a RandomWithResponse call
+func ParseRandomResponse(rsp *http.Response) (*RandomResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RandomResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IBANGeneration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseRandomBatchResponse parses an HTTP response from a RandomBatchWithResponse call
+func ParseRandomBatchResponse(rsp *http.Response) (*RandomBatchResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RandomBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IBANGeneration
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseTyposResponse parses an HTTP response from a TyposWithResponse call
+func ParseTyposResponse(rsp *http.Response) (*TyposResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TyposResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Typo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateResponse parses an HTTP response from a ValidateWithResponse call
+func ParseValidateResponse(rsp *http.Response) (*ValidateResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IBANValidation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseValidateBatchResponse parses an HTTP response from a ValidateBatchWithResponse call
+func ParseValidateBatchResponse(rsp *http.Response) (*ValidateBatchResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ValidateBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []IBANValidation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// The by the generator supported BICs.
+	// (GET /v1/bics)
+	Bics(w http.ResponseWriter, r *http.Request, params BicsParams)
+	// Convert a German account to an iban.
+	// (GET /v1/convert)
+	Convert(w http.ResponseWriter, r *http.Request, params ConvertParams)
+	// The by the generator country codes.
+	// (GET /v1/countryCodes)
+	CountryCodes(w http.ResponseWriter, r *http.Request)
+	// Edge case ibans of a country.
+	// (GET /v1/edgecases)
+	Edgecases(w http.ResponseWriter, r *http.Request, params EdgecasesParams)
+	// Enumerate the ibans of a bank.
+	// (GET /v1/enumerate)
+	Enumerate(w http.ResponseWriter, r *http.Request, params EnumerateParams)
+	// Check a German account number.
+	// (GET /v1/kontocheck)
+	Kontocheck(w http.ResponseWriter, r *http.Request, params KontocheckParams)
+	// Generate an iban.
+	// (GET /v1/random)
+	Random(w http.ResponseWriter, r *http.Request, params RandomParams)
+	// Generate multiple ibans.
+	// (GET /v1/randomBatch)
+	RandomBatch(w http.ResponseWriter, r *http.Request, params RandomBatchParams)
+	// Typo variants of an iban.
+	// (GET /v1/typos)
+	Typos(w http.ResponseWriter, r *http.Request, params TyposParams)
+	// Validate an iban.
+	// (GET /v1/validate)
+	Validate(w http.ResponseWriter, r *http.Request, params ValidateParams)
+	// Validate multiple ibans.
+	// (POST /v1/validate)
+	ValidateBatch(w http.ResponseWriter, r *http.Request)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// Bics operation middleware
+func (siw *ServerInterfaceWrapper) Bics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BicsParams
+
+	// ------------- Optional query parameter "countryCode" -------------
+	if paramValue := r.URL.Query().Get("countryCode"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "countryCode", r.URL.Query(), &params.CountryCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter countryCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "bank" -------------
+	if paramValue := r.URL.Query().Get("bank"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "bank", r.URL.Query(), &params.Bank)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bank: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Bics(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Convert operation middleware
+func (siw *ServerInterfaceWrapper) Convert(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConvertParams
+
+	// ------------- Required query parameter "bankCode" -------------
+	if paramValue := r.URL.Query().Get("bankCode"); paramValue != "" {
+
+	} else {
+		err := fmt.Errorf("Query argument bankCode is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "bankCode", r.URL.Query(), &params.BankCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bankCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Required query parameter "accountNo" -------------
+	if paramValue := r.URL.Query().Get("accountNo"); paramValue != "" {
+
+	} else {
+		err := fmt.Errorf("Query argument accountNo is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accountNo", r.URL.Query(), &params.AccountNo)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter accountNo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Convert(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// CountryCodes operation middleware
+func (siw *ServerInterfaceWrapper) CountryCodes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CountryCodes(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Edgecases operation middleware
+func (siw *ServerInterfaceWrapper) Edgecases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EdgecasesParams
+
+	// ------------- Required query parameter "countryCode" -------------
+	if paramValue := r.URL.Query().Get("countryCode"); paramValue != "" {
+
+	} else {
+		err := fmt.Errorf("Query argument countryCode is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "countryCode", r.URL.Query(), &params.CountryCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter countryCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Edgecases(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Enumerate operation middleware
+func (siw *ServerInterfaceWrapper) Enumerate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EnumerateParams
+
+	// ------------- Optional query parameter "bic" -------------
+	if paramValue := r.URL.Query().Get("bic"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "bic", r.URL.Query(), &params.Bic)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bic: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "bankCode" -------------
+	if paramValue := r.URL.Query().Get("bankCode"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "bankCode", r.URL.Query(), &params.BankCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bankCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "countryCode" -------------
+	if paramValue := r.URL.Query().Get("countryCode"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "countryCode", r.URL.Query(), &params.CountryCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter countryCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "skipInvalid" -------------
+	if paramValue := r.URL.Query().Get("skipInvalid"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "skipInvalid", r.URL.Query(), &params.SkipInvalid)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter skipInvalid: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+	if paramValue := r.URL.Query().Get("cursor"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Enumerate(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Kontocheck operation middleware
+func (siw *ServerInterfaceWrapper) Kontocheck(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params KontocheckParams
+
+	// ------------- Required query parameter "bankCode" -------------
+	if paramValue := r.URL.Query().Get("bankCode"); paramValue != "" {
+
+	} else {
+		err := fmt.Errorf("Query argument bankCode is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "bankCode", r.URL.Query(), &params.BankCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bankCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Required query parameter "accountNo" -------------
+	if paramValue := r.URL.Query().Get("accountNo"); paramValue != "" {
+
+	} else {
+		err := fmt.Errorf("Query argument accountNo is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "accountNo", r.URL.Query(), &params.AccountNo)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter accountNo: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Kontocheck(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// Random operation middleware
+func (siw *ServerInterfaceWrapper) Random(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RandomParams
+
+	// ------------- Optional query parameter "bic" -------------
+	if paramValue := r.URL.Query().Get("bic"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "bic", r.URL.Query(), &params.Bic)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bic: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "bankCode" -------------
+	if paramValue := r.URL.Query().Get("bankCode"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "bankCode", r.URL.Query(), &params.BankCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bankCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "countryCode" -------------
+	if paramValue := r.URL.Query().Get("countryCode"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "countryCode", r.URL.Query(), &params.CountryCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter countryCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "qrIban" -------------
+	if paramValue := r.URL.Query().Get("qrIban"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "qrIban", r.URL.Query(), &params.QrIban)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter qrIban: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "qrReference" -------------
+	if paramValue := r.URL.Query().Get("qrReference"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "qrReference", r.URL.Query(), &params.QrReference)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter qrReference: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "template" -------------
+	if paramValue := r.URL.Query().Get("template"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "template", r.URL.Query(), &params.Template)
 	if err != nil {
-		return nil, err
+		err = fmt.Errorf("Invalid format for parameter template: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
 	}
-	return ParseCountryCodesResponse(rsp)
-}
 
-// RandomWithResponse request returning *RandomResponse
-func (c *ClientWithResponses) RandomWithResponse(ctx context.Context, params *RandomParams, reqEditors ...RequestEditorFn) (*RandomResponse, error) {
-	rsp, err := c.Random(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	// ------------- Optional query parameter "invalid" -------------
+	if paramValue := r.URL.Query().Get("invalid"); paramValue != "" {
+
 	}
-	return ParseRandomResponse(rsp)
-}
 
-// ParseBicsResponse parses an HTTP response from a BicsWithResponse call
-func ParseBicsResponse(rsp *http.Response) (*BicsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "invalid", r.URL.Query(), &params.Invalid)
 	if err != nil {
-		return nil, err
+		err = fmt.Errorf("Invalid format for parameter invalid: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
 	}
 
-	response := &BicsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []BIC
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter format: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Optional query parameter "seed" -------------
+	if paramValue := r.URL.Query().Get("seed"); paramValue != "" {
 
 	}
 
-	return response, nil
-}
-
-// ParseCountryCodesResponse parses an HTTP response from a CountryCodesWithResponse call
-func ParseCountryCodesResponse(rsp *http.Response) (*CountryCodesResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "seed", r.URL.Query(), &params.Seed)
 	if err != nil {
-		return nil, err
+		err = fmt.Errorf("Invalid format for parameter seed: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
 	}
 
-	response := &CountryCodesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "replay" -------------
+	if paramValue := r.URL.Query().Get("replay"); paramValue != "" {
+
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	err = runtime.BindQueryParameter("form", true, false, "replay", r.URL.Query(), &params.Replay)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter replay: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Random(w, r, params)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler(w, r.WithContext(ctx))
 }
 
-// ParseRandomResponse parses an HTTP response from a RandomWithResponse call
-func ParseRandomResponse(rsp *http.Response) (*RandomResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// RandomBatch operation middleware
+func (siw *ServerInterfaceWrapper) RandomBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	response := &RandomResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IBANGeneration
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RandomBatchParams
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
+	// ------------- Required query parameter "count" -------------
+	if paramValue := r.URL.Query().Get("count"); paramValue != "" {
 
+	} else {
+		err := fmt.Errorf("Query argument count is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
 	}
 
-	return response, nil
-}
+	err = runtime.BindQueryParameter("form", true, true, "count", r.URL.Query(), &params.Count)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter count: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// The by the generator supported BICs.
-	// (GET /v1/bics)
-	Bics(w http.ResponseWriter, r *http.Request, params BicsParams)
-	// The by the generator country codes.
-	// (GET /v1/countryCodes)
-	CountryCodes(w http.ResponseWriter, r *http.Request)
-	// Generate an iban.
-	// (GET /v1/random)
-	Random(w http.ResponseWriter, r *http.Request, params RandomParams)
-}
+	// ------------- Optional query parameter "bic" -------------
+	if paramValue := r.URL.Query().Get("bic"); paramValue != "" {
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	}
 
-type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+	err = runtime.BindQueryParameter("form", true, false, "bic", r.URL.Query(), &params.Bic)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bic: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
 
-// Bics operation middleware
-func (siw *ServerInterfaceWrapper) Bics(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "bankCode" -------------
+	if paramValue := r.URL.Query().Get("bankCode"); paramValue != "" {
 
-	var err error
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params BicsParams
+	err = runtime.BindQueryParameter("form", true, false, "bankCode", r.URL.Query(), &params.BankCode)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter bankCode: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
 
 	// ------------- Optional query parameter "countryCode" -------------
 	if paramValue := r.URL.Query().Get("countryCode"); paramValue != "" {
@@ -643,35 +2881,56 @@ func (siw *ServerInterfaceWrapper) Bics(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// ------------- Optional query parameter "bank" -------------
-	if paramValue := r.URL.Query().Get("bank"); paramValue != "" {
+	// ------------- Optional query parameter "qrIban" -------------
+	if paramValue := r.URL.Query().Get("qrIban"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "bank", r.URL.Query(), &params.Bank)
+	err = runtime.BindQueryParameter("form", true, false, "qrIban", r.URL.Query(), &params.QrIban)
 	if err != nil {
-		err = fmt.Errorf("Invalid format for parameter bank: %w", err)
+		err = fmt.Errorf("Invalid format for parameter qrIban: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
 		return
 	}
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Bics(w, r, params)
+	// ------------- Optional query parameter "qrReference" -------------
+	if paramValue := r.URL.Query().Get("qrReference"); paramValue != "" {
+
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "qrReference", r.URL.Query(), &params.QrReference)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter qrReference: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
 
-// CountryCodes operation middleware
-func (siw *ServerInterfaceWrapper) CountryCodes(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter format: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
+
+	// ------------- Optional query parameter "seed" -------------
+	if paramValue := r.URL.Query().Get("seed"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "seed", r.URL.Query(), &params.Seed)
+	if err != nil {
+		err = fmt.Errorf("Invalid format for parameter seed: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
+		return
+	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CountryCodes(w, r)
+		siw.Handler.RandomBatch(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -681,53 +2940,96 @@ func (siw *ServerInterfaceWrapper) CountryCodes(w http.ResponseWriter, r *http.R
 	handler(w, r.WithContext(ctx))
 }
 
-// Random operation middleware
-func (siw *ServerInterfaceWrapper) Random(w http.ResponseWriter, r *http.Request) {
+// Typos operation middleware
+func (siw *ServerInterfaceWrapper) Typos(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params RandomParams
+	var params TyposParams
 
-	// ------------- Optional query parameter "bic" -------------
-	if paramValue := r.URL.Query().Get("bic"); paramValue != "" {
+	// ------------- Required query parameter "iban" -------------
+	if paramValue := r.URL.Query().Get("iban"); paramValue != "" {
 
+	} else {
+		err := fmt.Errorf("Query argument iban is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "bic", r.URL.Query(), &params.Bic)
+	err = runtime.BindQueryParameter("form", true, true, "iban", r.URL.Query(), &params.Iban)
 	if err != nil {
-		err = fmt.Errorf("Invalid format for parameter bic: %w", err)
+		err = fmt.Errorf("Invalid format for parameter iban: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
 		return
 	}
 
-	// ------------- Optional query parameter "bankCode" -------------
-	if paramValue := r.URL.Query().Get("bankCode"); paramValue != "" {
+	// ------------- Optional query parameter "kind" -------------
+	if paramValue := r.URL.Query().Get("kind"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "bankCode", r.URL.Query(), &params.BankCode)
+	err = runtime.BindQueryParameter("form", true, false, "kind", r.URL.Query(), &params.Kind)
 	if err != nil {
-		err = fmt.Errorf("Invalid format for parameter bankCode: %w", err)
+		err = fmt.Errorf("Invalid format for parameter kind: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
 		return
 	}
 
-	// ------------- Optional query parameter "countryCode" -------------
-	if paramValue := r.URL.Query().Get("countryCode"); paramValue != "" {
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Typos(w, r, params)
+	}
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "countryCode", r.URL.Query(), &params.CountryCode)
+	handler(w, r.WithContext(ctx))
+}
+
+// Validate operation middleware
+func (siw *ServerInterfaceWrapper) Validate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ValidateParams
+
+	// ------------- Required query parameter "iban" -------------
+	if paramValue := r.URL.Query().Get("iban"); paramValue != "" {
+
+	} else {
+		err := fmt.Errorf("Query argument iban is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "iban", r.URL.Query(), &params.Iban)
 	if err != nil {
-		err = fmt.Errorf("Invalid format for parameter countryCode: %w", err)
+		err = fmt.Errorf("Invalid format for parameter iban: %w", err)
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err})
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Random(w, r, params)
+		siw.Handler.Validate(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// ValidateBatch operation middleware
+func (siw *ServerInterfaceWrapper) ValidateBatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ValidateBatch(w, r)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -803,12 +3105,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/bics", wrapper.Bics)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/convert", wrapper.Convert)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/countryCodes", wrapper.CountryCodes)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/edgecases", wrapper.Edgecases)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/enumerate", wrapper.Enumerate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/kontocheck", wrapper.Kontocheck)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/random", wrapper.Random)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/randomBatch", wrapper.RandomBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/typos", wrapper.Typos)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/validate", wrapper.Validate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/validate", wrapper.ValidateBatch)
+	})
 
 	return r
 }
@@ -816,19 +3142,71 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7RVzW7bPBB8FWK/76jacnMoqlvsJIUO/UEQ9BLkQFNri4lEKuTKrRHo3YulpMQ/sp2g",
-	"6ckyxZ3dGQ5HT6BsWVmDhjwkT+DQV9Z4DH8unbPuulvhBWUNoSF+lFVVaCVJWzO+99bwmlc5lpKf/ne4",
-	"gAT+G7+gj9u3fhxQoWmaCDL0yumKQSCBcyOQ34l+iBHwpq6OYafpjH+2y25yFBmS1IUX03Q2gggqZyt0",
-	"pFsac2ke+JfWFUICnpw2S2gimGs1uK5sbcitZzbDgfdNBA4fa+0wg+Q2gGyXRG3Hu6ivtPN7VMTILfc9",
-	"CgPMd0lgX3l8mnbbUOt0ev7tCxp0sm16TEa7EFIs282YCT2XZlhWNSzRYWkZ6jSLsCt66bDPhyu0Wdhh",
-	"Hr5CJRbW9Ry0WQYSnlkUWmHnZyNLBv2a3vBspKngv7zzQ1dpHUSwQudb8Hg0GcW811ZoZKUhgbNRPIpZ",
-	"HEl50GW8moznWoXnJdKBCeuqso7FnaazMBZLG44mzSCBKQMwqJMlEjoPye0uzjVS7YywplgHlMCYchSd",
-	"GQVrx9Cadz/W6NYQ9Zy3Dftyc/G3LKsgw8UlRPvndHIIyiWJUpLKwyx8hoJ7Cmky4a0jXi7FL025KHCF",
-	"xueE2ohMe5JGHRw43KnhSX9YT93r3Xnvou1I+xjHbwoyTVj6U4nGsdQ895bOyfVQvv2Uhc5CI8HWdWX7",
-	"3J/aUq/QCL6mIwi1C1kXdKj3M6vxdkqHxKzLUrp1Z7X5uoXvDb3nPS5h025Y4rXm3XTagItnm4jvdRJ7",
-	"mXJK9xMi7HD4p9Lv9uqUd9JktjyoeXfHhiJ5W+/rFudEbvBk03QmyIra41ZOWnPw+oXP3NDtm32fnl9c",
-	"Xl2dfYonr0mMmz4VWIQ3DiHNw5HEmsTx5ziOXzvE5mF0c7xzWv5t+hwLnZ3v+YDv042QkXNbk5Dhy6gX",
-	"WoUTeD+zd4NwyHfWbJqm+RMAAP//LCtfZ10KAAA=",
+	"H4sIAAAAAAAC/+xcX3PcNpL/KijePbno8cj2XmK9SbK80W3Wycq+vdR584Ahe4aISIAGQEmTlL77VTdA",
+	"EiTBEeVIvktVXhLNgAQa/ffXf8a/JZmqaiVBWpMc/5bUXPMKLGj69E7pilv8KweTaVFboWRynFyCbbRk",
+	"tgAmNlwyQX8bYBpqDQak5fikWTFelkzT04bBNej96JFVkiYCt/zcgN4naSJ5BclxsnUnp4nJCqg4kiAs",
+	"VEQVyKZKjj8lUEJmtZIiS9Kk1kLi8xU3V5AnadJokaQJL8vk5zSx+xp3NVYLuUvuui+41nyf3N2lySXU",
+	"Jd9Pr3qCBJd8z6y6AsnUlnFWa7gWqjFMg6mVNLBiHwu8/OcGjGUCF2rgFnJ2I2xBfDIAOeMyZz2HcTNc",
+	"cjvjGnJL2QJ0+BTXwMROKg35HLcciQNujW58lyYfAPLp/T62tG2VJmp2IEE72bBLdyMTXINX8bvoXiXo",
+	"GQ2mKe2KnTDNZa4q95IwrDH4/y1TlbB2/kr4+OBCXiOOEyHtf7xOOhEKaWEHOrnDO7YCIT0511rpS/8N",
+	"fpEpaUGSPvO6LkVG13zxi0FO/Bac9e8atslx8m8veuN44VbNC9rVnTbSFMkA13qtILb793Db04uzuABy",
+	"sFyUhp1enK1Il1UN2gp3jQ2XVxGJpslGZNHvM9VIq/dnKoe4JqCiCo3a8Ik2Gb6SuhN7q1GbXyCzuPN5",
+	"voMzbmB6ixN2zUuRO3fALeNsoxqZc71vtZxWjNVNZhsNzpD8sfE7Z3H65+89oCiyjhREF5zKxQRzJWSO",
+	"pEK+A5ZxlGna+Z+sgOzqrdgJa9YvkzT8/ObbJE1+Ba1OMrrje5WkiRQSws8lWAv6O+DXe/rEcyF3/wNa",
+	"nXJ55UVRKrkDYyM+bCRHusOQB/7Gac/NqExJn49/u1+bx0KC9s3DlLnHYkdfnJ68P1PyGrTxMptKgNTG",
+	"Kws+iU6VOyZOKeIdd6N7+WUmm2oDOlTMlN0UIitYxiXLxXYLmm21qmi9hB3P9qOXV0kkqIRaOz0dVxku",
+	"P/Dg7r34mTPGMKvsuilnCERxMFxmtuCW3XDDyE06J31YyGNNSwNZ+DPnNMCBDBNDGQMwQVogiWsRXeyx",
+	"QPRu6Mh5ZjHKVRTNVGOZqXkGhkz6llc18iV5e/7tm1ffrF+v169fr//y6uX66NV6vY5x3iON6HGERvrD",
+	"KLBvGkti9U6PRJq6b9BxsJw8B8VVkj43uEOjWVZwzTOKsu7MKcXs2bNnzwb/ebVmcbKJtBmqeQ3aUS0k",
+	"22nV1MR1pCJyJrKJIZ8YMoqtj2bPbLQ4YN3/dXkx3B2/PV4qiLsZtfprh2MOx1zyLR70QD6jXl9g2Sv2",
+	"TmlSWBmERmHRjxmBoMo/HIiXOw2plRGdytvwgJQZ5fewXEhDqzlsIbOIqYJPWcHlDvLh64c8yPRaEg2z",
+	"FL9CjsCkdwqN8XAxgIqxjR0lc9wnKtV2zKGZAEsBUu5s0YOVLoIa73h8yJREES/PurejGcC2dzuH4F7o",
+	"oQ651c/6EragQWZRdPSPS6bbdeLdhxthDPvH5fONKEsT5Z9D9R8xO4jGZ1Xzz02bPpB0NNRa5U0GTjHa",
+	"0M1uCiDVE4bV3KD4ePsEHtHj+BQXEHO0D5Dq5Nxy56mM0tZpuuWbEjpn5UM4yhM59HwHkvKWRno9/JII",
+	"Et5/Lnj8yHdRftd8R9aIqhRYtpmadtZoo/QhBrsnugRJwq2l/VfsglhaCWOE3DEle8dN6zGhEhWDdPb+",
+	"3HTMJjPLjn+iHR1wei4rCyIpu+7eeEjm8SXw/HBakvZwcizLoqm4fK6B56RzwXLrH7dclI2eZ3j0vN69",
+	"HYhNLVS4KYQFwgspM4D2YpV2wbrWsBW3TEjW1Bg/MU1A8xHOW3oGR00ARcvNnLAqnhVCQn9z9zC7KfZB",
+	"6cW07jP0nI28kupGnnWe0j/zfetDRYnosjzrYk//zOnpyfv+E3lR01TLHatpdpiyYPya3uufnaM3zmWF",
+	"oHccLDd7xhmaVgnMNBtjhW3QkruIydAkNZemjZik2fkvPANp+8fMil3QiWrLiC/k1gyCQmBboQ2lEp1B",
+	"HgoHH7rLTS01TYj2QNk2SpXA5ZyvCzSwfTdm2H9T0ipi+gKjHuU4FELvSZQeZt5PZKYV2ELNWCJdgrkn",
+	"luQmv8+s3GlIK8RsKkjOK14iioA8zOobaZq6Vpio/t3dKawMRC1mqdrMZFfzmhNo6+GKDTG1ErvCsoJf",
+	"A9sAoC1akDnkU/VpA8OUuZJXMECtYoRg0WERHx/mrLEQM5fZaQ0ZuoVKGMuvBiWazmu4esjAV0RF0S1G",
+	"zxIyh9tOm9FxBMd37mYVK05GHQDdKk0iFPUy/LivVVx6WnA5iOUu1WR2XyMcCdgxQjy82RURXP7fBVDt",
+	"GS9Xqfz5m2+cLZimYhm3WeFhZbDxWGEPCaqtpT1UTJhIlND+2XR1W6qOqSteiitXKbsBjbH3keTq86eD",
+	"Uk0TL4X7q2BjUfevpq1AptLHTYTczpSzTA3ZIA+Tux7kliIDX/b2FfW/X3ykmCVsl2Q/928qjfS0Zbhk",
+	"vTparfFZVYPktUiOk1er9QqT75rbgpToxfXRi43I6O8dzGR5nSfEBJLIQj0k6V3kyXFyihukg6bTp5le",
+	"k5LlnnbpcHhYR5nrIgzr2n2BP6xkxDKTe4lwDhONondveCYhQsqSbAG++FPCNUhTWBCS5aj8MpslGDea",
+	"ofRHZaxfHtP786jz8XK9flC/YxH0we7FNDu5S2MIjw5iqLq6cn937SVxDZJKjStfI+BNaefO7m71YtjM",
+	"uSOcWVVc79sazD7sXik90T18BZXWl5Fn9dYVpC3j7K+gKy6D0IWyHeErq4be1w7qqN6jvIXGIhfZaSNz",
+	"MLRhyw/8sGKnhEfbZIO7t4XsnqAUHAsvwwO8HppC1O741qhX7B0Vt+jLv6yP2jAcUkX7CsOkskygjlUg",
+	"fZoytFLPkfsMFaUwZtkhNT9rs/zWSVrdQFz12zrgElOdFvrniBhUqBdQcagI+Xst8L4SVNAmidhc2Cix",
+	"/f0fz8ImRtFyuNf/0MI6p7s0PIS+3MQ0MNjxsXzdfXWXKJcPuJnRHZ7UuY3P8pzHNiVCoHm2+0DGWY5m",
+	"XAkpjBUZM0BA8jrIzn0t2rdxBZgFjdyUwWq36j3hoLGxfkkO9M23TOmRhdLe2C41FGJX7Lztt7ZVAkVu",
+	"Cm6FsZ3rbJnANbAStpapxk5157zjyQL/FfIVdbsxC6HFEv/x9vwJHMei0N317RdqOfTsb5sUbaf+sdS6",
+	"k7BXt+E8QKfQbfn2PoWmInSgvbRdH7mpHLzZu/8LybjJQOZUtNV51woWE71csR+5cVmPrwLTxrSNVWwH",
+	"dlwSpiS46y+P1ZyUGfXVZFxK30bx+wnDSlEJCzl1efA7usemwwbM1FybYT3EUOuYkvYt3IDua2u+Go1b",
+	"osVJJSEGC8KKmxs2Mleirv2Ejx2XX7w+hIF+CYY47wS5wAqx2xSAlBVD3U2DGiyRxrvC+08//cQ2msus",
+	"cNJeMDHlxl5iVnr2w+nJ2/N37159sz5aijcmDUD8IkXuSsU2IkMWEfBdgIceA/8M3Ni4h7hib50BG1Th",
+	"t+dPnTt9uBL1GDYM7AFLbbOKRgowQyEq6oVT39jsW1BGi9cBb0XVVIG1Bq7DGXPIqKP1eo4OsrEBBX7v",
+	"5PhovV6nCcZZ9zFWGYpKsPM21BBuBw4dWSexMUG4zaC23t7RArDIIvtpErfjrLBp9eAE4VNDXGrlxebq",
+	"umaeL248WghqfVIHa4K40cegKyWtytryezxnxFU092kBXhhWl7wxAivNzttP8soRXhoawH3JY5dnDR3u",
+	"33qq/8zb/m/ytqBvM4Oz+s5NJ/1HzNlImXjc9fbq7cZz708YRiMyMwWGx8AKl46ihUDBofTxPMofCDLE",
+	"L/BlQOFovX4zMyX1qOnOl6MBP5YFjPcjMFTPQgXSDVByyDTsmpJr1q5seYlT9j9g/bXPuGOVYMPOviNZ",
+	"f38xjN9n381d7bO+cO2YhyCIk9KoziYYXzLgEz+7Hxt6GAE9I2XYwwv6NBaquuQWVuwDDTqGSp6yZ92z",
+	"XO5xQFHdDFrryMNPpXpeiJ8Hu7bQjYBFqZC1hehDWI+mfNqvtqxUK/ahQJnpjihHTc3zvP2VxI0o84zr",
+	"3KzY2WAgUrvxTWz9h9inN5YIDAr4Sjdps5zQzkMX9vrlSw/Xg65ojJm0m/d6r1qvZ4DrrPDhveR6Fzyv",
+	"gWcFek3r8zu2ga1CMmi8k7zScPwtpiftdrO29+xZMI/55o0fBH2YRY7GLzqZupq9G9lzGa77m7XeqDME",
+	"M8i6SSOFYb5zjqoyKGgP9ppOl7CNBn7ltc6vDktKPsDgxApTOiUB+GhDcG4yXjsqkHptbYewPZigS4jM",
+	"eaJ7IZGIJCBfYYDxLo1jg94UXvhfby14kn4atOA5/yOpJ08FgtldhCnhZrfPZf47N5xAsYugT8U3rgOD",
+	"zVWxFVmbDDwSKBt77TEOO0WXcx8Yq5rSihpnbISxQmZ2BM7Mip3Tb+3wAytoFs0wtMDBb9nGI6PCOpPv",
+	"qVmx78mZbbwjzBDGATNWA6/cFKmEmxKnaXLwxSv2nx9+eN/7jpOM/HEBPAfNYpIc+uH1uvWPXPoLCNOf",
+	"KLa+zNVdPaxeUX3YF6E3kHHEVWobdx5p6MmfAL86SS4AseP6g+1xxYqdWFYpY7H6sCb3Rtyl4q2bPsSF",
+	"du39W1p1zDIHkdyy1Onoi2oXf6LyPy4qH2ByE4LyEJKbPzG5fJIQ/FW6QeOAGJk4f+qI+7HvZnYx6/Fj",
+	"bBcn2wN8qLX7WpllHSUa0fJlwTE07maThwN3Lq48fJZuGEQ+Eo0LwkdAlVUsBy2uYUg8ZmpT1zuZY+eS",
+	"/Yt+RPCvpJ1pX+B//STjsv7n0l90HRq/6q7lIYYwscnCGKl+BG8K0596/PDrmDVqzNIGL1rAQLu7X609",
+	"3tzC+IgJ4m1/GTFriX6UrC9xoJr6f2hATDC7sKbrFg1tqd1niTm1htQS94cynKfOy4KfF/3/mPwba8jK",
+	"z/oeVCZWCmN7vH1QqdxUALXg5/Wqhfr+HwA5Vfn+QWzvJP1pTtQ/p79zbqlLLTq9nuje3dfCHqEWPeI8",
+	"qWEi+PdHaK7jCRRtiinu7v53AMzgK9pFRwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -2,25 +2,51 @@ package bic
 
 import (
 	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"hash"
 	"io"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/leonnicolas/iban-gen/iban"
 )
 
+// Normalize turns user input into a BIC with eleven characters.
+// It removes whitespace, converts letters to upper case and appends
+// the branch code XXX of the head office to BICs with eight characters,
+// e.g. " cobadeff" becomes "COBADEFFXXX".
+// The result is not validated.
+func Normalize(bic string) string {
+	bic = strings.ToUpper(strings.Join(strings.Fields(bic), ""))
+	if len(bic) == 8 {
+		bic += "XXX"
+	}
+	return bic
+}
+
 // Bank represents a bank.
 type Bank struct {
 	CountryCode iban.CountryCode
 	Bank        string
 	BankCode    string
 	BIC         string
+	// CheckMethod is the check method of the Deutsche Bundesbank for account numbers.
+	CheckMethod string
+	// IBANRule is the IBAN rule of the Deutsche Bundesbank for the bank.
+	// It is empty if the bank data does not contain the IBAN rule column,
+	// which was added to the Bundesbank file in 2013.
+	IBANRule string
 }
 
 // BankRepo contains Banks and enables queries.
 type BankRepo struct {
-	bics map[string]Bank
+	bics  map[string]Bank
+	banks map[string]Bank
+	// hash is the checksum of all populated bank data.
+	hash hash.Hash
 }
 
 // NewBICRepo returns a new BankRepo
@@ -38,17 +64,48 @@ func (re *BankRepo) BICs() []Bank {
 }
 
 // BankCode returns the BankCode of the bank of the given BIC.
+// The BIC is normalized with Normalize, so e.g. "cobadeff" finds the bank of "COBADEFFXXX".
 func (re *BankRepo) BankCode(bic string) (string, bool) {
-	b, ok := re.bics[bic]
+	b, ok := re.bics[Normalize(bic)]
 	return b.BankCode, ok
 }
 
+// Bank returns the bank with the given country and bank code.
+func (re *BankRepo) Bank(cc iban.CountryCode, bc string) (Bank, bool) {
+	b, ok := re.banks[bc]
+	if !ok || b.CountryCode != cc {
+		return Bank{}, false
+	}
+	return b, true
+}
+
+// BankCodes returns the bank codes of the country in ascending order.
+func (re *BankRepo) BankCodes(cc iban.CountryCode) []string {
+	ret := make([]string, 0, len(re.banks))
+	for bc, b := range re.banks {
+		if b.CountryCode == cc {
+			ret = append(ret, bc)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Version returns a version of the bank data that changes with its content.
+func (re *BankRepo) Version() string {
+	if re.hash == nil {
+		return ""
+	}
+	return hex.EncodeToString(re.hash.Sum(nil)[:8])
+}
+
 // PopulateFromFile populates the BankRepo from a file.
 func (re *BankRepo) PopulateFromFile(path string) (int, error) {
 	f, err := os.Open(path)
 	if err != nil {
 		return 0, err
 	}
+	defer f.Close()
 	return re.Populate(f)
 }
 
@@ -57,21 +114,40 @@ func (re *BankRepo) Populate(r io.Reader) (int, error) {
 	if re.bics == nil {
 		re.bics = make(map[string]Bank)
 	}
+	if re.banks == nil {
+		re.banks = make(map[string]Bank)
+	}
+	if re.hash == nil {
+		re.hash = sha256.New()
+	}
 	s := bufio.NewReader(r)
 	c := 0
 	for l, err := s.ReadString('\n'); err == nil; l, err = s.ReadString('\n') {
 		if len(l) < 168 {
 			return 0, errors.New("invalid entry")
 		}
-		runeVal := []rune(l)
+		re.hash.Write([]byte(l))
+		runeVal := []rune(strings.TrimRight(l, "\r\n"))
 		bc := strings.TrimSpace(string(runeVal[0:8]))
-		bic := strings.TrimSpace(string(runeVal[139:150]))
+		bic := Normalize(string(runeVal[139:150]))
 		name := strings.TrimSpace(string(runeVal[9:67]))
-		re.bics[strings.Trim(bic, " ")] = Bank{
+		method := strings.TrimSpace(string(runeVal[150:152]))
+		var rule string
+		if len(runeVal) >= 174 {
+			rule = strings.TrimSpace(string(runeVal[168:174]))
+		}
+		b := Bank{
 			CountryCode: iban.CountryCodeDE,
 			BIC:         bic,
 			Bank:        name,
 			BankCode:    bc,
+			CheckMethod: method,
+			IBANRule:    rule,
+		}
+		re.bics[bic] = b
+		// Only the main entry of a bank code is marked with a 1.
+		if _, ok := re.banks[bc]; !ok || runeVal[8] == '1' {
+			re.banks[bc] = b
 		}
 		c++
 	}
@@ -0,0 +1,176 @@
+package bic
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+const bundesbankFile = "../cmd/iban-gen/data/bundesbank.txt"
+
+// records returns the main records of the bank codes from the embedded Bundesbank file.
+func records(t *testing.T, bcs ...string) []string {
+	f, err := os.Open(bundesbankFile)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	defer f.Close()
+	var ret []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		for _, bc := range bcs {
+			if strings.HasPrefix(s.Text(), bc+"1") {
+				ret = append(ret, strings.TrimRight(s.Text(), "\r"))
+			}
+		}
+	}
+	if len(ret) != len(bcs) {
+		t.Fatalf("got %d records expected=%d\n", len(ret), len(bcs))
+	}
+	return ret
+}
+
+func TestPopulateEmbedded(t *testing.T) {
+	re := NewBICRepo()
+	n, err := re.PopulateFromFile(bundesbankFile)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if n == 0 {
+		t.Errorf("got no entries\n")
+	}
+	b, ok := re.Bank(iban.CountryCodeDE, "37040044")
+	if !ok || b.BIC != "COBADEFFXXX" || b.CheckMethod != "13" {
+		t.Errorf("got %+v\n", b)
+	}
+	// The embedded file has no IBAN rule column, so the rule must not be guessed.
+	if b.IBANRule != "" {
+		t.Errorf("got rule %q expected none\n", b.IBANRule)
+	}
+	if _, err := iban.ConvertLegacy(b.BankCode, "532013000", b.IBANRule); !errors.Is(err, iban.ErrUnknownRule) {
+		t.Errorf("got err=%v expected=%v\n", err, iban.ErrUnknownRule)
+	}
+	if _, err := re.PopulateDefaultRules(); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	for _, tc := range []struct {
+		bc   string
+		rule string
+	}{
+		{bc: "37040044", rule: iban.RuleStandard},
+		{bc: "10050000", rule: "000400"},
+		{bc: "10070000", rule: "000200"},
+		{bc: "10070024", rule: "002000"},
+		{bc: "66050000", rule: "002500"},
+	} {
+		if b, ok := re.Bank(iban.CountryCodeDE, tc.bc); !ok || b.IBANRule != tc.rule {
+			t.Errorf("%s: got %+v expected rule %q\n", tc.bc, b, tc.rule)
+		}
+	}
+	for _, b := range re.BICs() {
+		if b.IBANRule == "" {
+			t.Errorf("got no rule for %+v\n", b)
+			break
+		}
+	}
+	exp, _ := re.BankCode("COBADEFFXXX")
+	if bc, ok := re.BankCode(" cobadeff"); !ok || bc != exp {
+		t.Errorf("got bank code %q expected=%q\n", bc, exp)
+	}
+}
+
+func TestPopulateRules(t *testing.T) {
+	// Real records with the IBAN rule column as in the Bundesbank file since 2013.
+	rules := map[string]string{
+		"37040044": "000000",
+		"10050000": "000400",
+		"10070000": "000200",
+		"10090000": "000800",
+	}
+	var sb strings.Builder
+	for _, r := range records(t, "37040044", "10050000", "10070000", "10090000") {
+		sb.WriteString(r + rules[r[:8]] + "\r\n")
+	}
+	re := NewBICRepo()
+	if _, err := re.Populate(strings.NewReader(sb.String())); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	for _, tc := range []struct {
+		bc  string
+		aNo string
+		out string
+		err error
+	}{
+		{bc: "37040044", aNo: "532013000", out: "DE89370400440532013000"},
+		{bc: "10050000", aNo: "135", out: "DE86100500000990021440"},
+		{bc: "10070000", aNo: "1234586000", err: iban.ErrNoIBAN},
+		{bc: "10090000", aNo: "532013000", out: "DE23100900000532013000"},
+	} {
+		b, ok := re.Bank(iban.CountryCodeDE, tc.bc)
+		if !ok || b.IBANRule != rules[tc.bc] {
+			t.Errorf("%s: got %+v\n", tc.bc, b)
+			continue
+		}
+		out, err := iban.ConvertLegacy(b.BankCode, tc.aNo, b.IBANRule)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s %s: got err=%v expected=%v\n", tc.bc, tc.aNo, err, tc.err)
+			continue
+		}
+		if err == nil && out.String() != tc.out {
+			t.Errorf("%s %s: got %s expected=%s\n", tc.bc, tc.aNo, out, tc.out)
+		}
+	}
+}
+
+func TestPopulateRulesKeepsColumn(t *testing.T) {
+	rs := records(t, "37040044", "10070000", "10090000")
+	// Only 10070000 has the IBAN rule column.
+	var sb strings.Builder
+	for _, r := range rs {
+		if strings.HasPrefix(r, "10070000") {
+			r += "000000"
+		}
+		sb.WriteString(r + "\r\n")
+	}
+	re := NewBICRepo()
+	if _, err := re.Populate(strings.NewReader(sb.String())); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	n, err := re.PopulateRules(strings.NewReader("# comment\n37040044000100\n10070000000200\n"))
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if n != 2 {
+		t.Errorf("got %d rules expected=%d\n", n, 2)
+	}
+	for bc, rule := range map[string]string{
+		"37040044": "000100",
+		"10070000": "000000",
+		"10090000": iban.RuleStandard,
+	} {
+		if b, _ := re.Bank(iban.CountryCodeDE, bc); b.IBANRule != rule {
+			t.Errorf("%s: got rule %q expected=%q\n", bc, b.IBANRule, rule)
+		}
+	}
+	if _, err := re.PopulateRules(strings.NewReader("3704004400010\n")); err == nil {
+		t.Errorf("got no error for a malformed rule\n")
+	}
+}
+
+func TestDefaultRulesImplemented(t *testing.T) {
+	// Every rule that ships with iban-gen must be implemented.
+	s := bufio.NewScanner(strings.NewReader(ibanRules))
+	for s.Scan() {
+		l := s.Text()
+		if strings.HasPrefix(l, "#") {
+			continue
+		}
+		if _, err := iban.ConvertLegacy(l[:8], "532013000", l[8:]); errors.Is(err, iban.ErrUnsupportedRule) || errors.Is(err, iban.ErrUnknownRule) {
+			t.Errorf("%s: got err=%q\n", l, err.Error())
+		}
+	}
+}
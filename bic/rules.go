@@ -0,0 +1,66 @@
+package bic
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+// ibanRules contains the IBAN rules that ship with iban-gen,
+// because the embedded Bundesbank file has no IBAN rule column.
+//
+//go:embed ibanrules.txt
+var ibanRules string
+
+// PopulateRules sets the IBAN rules of the German banks of the BankRepo from a io.Reader
+// with a bank code and its rule per line. Lines starting with # are ignored.
+// Banks that got a rule from the IBAN rule column of the bank data keep it
+// and all other banks that are not listed get iban.RuleStandard,
+// so PopulateRules must be called after the bank data was populated.
+// It returns the number of rules that were read.
+func (re *BankRepo) PopulateRules(r io.Reader) (int, error) {
+	rules := make(map[string]string)
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		l := strings.TrimSpace(s.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+		if len(l) != 14 {
+			return 0, fmt.Errorf("invalid IBAN rule %q", l)
+		}
+		rules[l[:8]] = l[8:]
+		if re.hash != nil {
+			re.hash.Write([]byte(l))
+		}
+	}
+	if err := s.Err(); err != nil {
+		return 0, err
+	}
+	set := func(b Bank) Bank {
+		if b.CountryCode != iban.CountryCodeDE || b.IBANRule != "" {
+			return b
+		}
+		b.IBANRule = iban.RuleStandard
+		if rule, ok := rules[b.BankCode]; ok {
+			b.IBANRule = rule
+		}
+		return b
+	}
+	for k, b := range re.bics {
+		re.bics[k] = set(b)
+	}
+	for k, b := range re.banks {
+		re.banks[k] = set(b)
+	}
+	return len(rules), nil
+}
+
+// PopulateDefaultRules sets the IBAN rules that ship with iban-gen with PopulateRules.
+func (re *BankRepo) PopulateDefaultRules() (int, error) {
+	return re.PopulateRules(strings.NewReader(ibanRules))
+}
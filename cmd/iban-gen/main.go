@@ -23,7 +23,10 @@ import (
 
 	v1 "github.com/leonnicolas/iban-gen/api/v1"
 	"github.com/leonnicolas/iban-gen/bic"
+	"github.com/leonnicolas/iban-gen/iban"
+	"github.com/leonnicolas/iban-gen/redact"
 	"github.com/leonnicolas/iban-gen/server"
+	"github.com/leonnicolas/iban-gen/sortcode"
 	"github.com/leonnicolas/iban-gen/version"
 )
 
@@ -62,11 +65,22 @@ var (
 
 // Main is the principal function for the binary, wrapped only by `main` for convenience.
 func Main() error {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "scan":
+			return scan(os.Args[2:])
+		case "pseudonymize":
+			return pseudonymize(os.Args[2:])
+		}
+	}
+
 	listen := flag.String("listen", ":8080", "The address at which to listen.")
 	listenInternal := flag.String("listen-internal", ":9090", "The address at which to listen for health and metrics.")
 	healthCheckURL := flag.String("healthchecks-url", "http://localhost:8080", "The URL against which to run healthchecks.")
 	logLevel := flag.String("log-level", logLevelInfo, fmt.Sprintf("Log level to use. Possible values: %s", availableLogLevels))
 	logFmt := flag.String("log-fmt", logFmtFmt, fmt.Sprintf("Log format to use. Possible values: %s", availableLogFmts))
+	sortCodeFile := flag.String("sort-code-file", "", "The path to a Vocalink sort code weight table to check GB account numbers.")
+	bankFile := flag.String("bank-file", "", "The path to a Bundesbank bank code file to use instead of the embedded one, e.g. to get current IBAN rules.")
 	help := flag.Bool("h", false, "Show usage")
 	printVersion := flag.Bool("version", false, "Show version")
 
@@ -91,6 +105,8 @@ func Main() error {
 	default:
 		return fmt.Errorf("log format %v unknown; possible values are: %s", *logFmt, availableLogFmts)
 	}
+	// IBANs are masked before they are written, so that real account numbers never reach the logs.
+	logger = redact.NewLogger(logger)
 
 	switch *logLevel {
 	case logLevelAll:
@@ -112,6 +128,16 @@ func Main() error {
 	logger = log.With(logger, "caller", log.DefaultCaller)
 	stdlog.SetOutput(log.NewStdlibAdapter(logger))
 
+	if *sortCodeFile != "" {
+		t := sortcode.NewTable()
+		i, err := t.PopulateFromFile(*sortCodeFile)
+		if err != nil {
+			return fmt.Errorf("failed to load sort code table: %v", err)
+		}
+		iban.SetSortCodeTable(t)
+		level.Info(logger).Log("msg", "loaded sort code table", "entries", i)
+	}
+
 	reg := prometheus.NewRegistry()
 	reg.MustRegister(
 		prometheus.NewGoCollector(),
@@ -138,13 +164,7 @@ func Main() error {
 				}
 				return http.HandlerFunc(fn)
 			})
-			bicsRepo := bic.NewBICRepo()
-			f, err := bankData.Open(bundesbankFile)
-			if err != nil {
-				return err
-			}
-			defer f.Close()
-			i, err := bicsRepo.Populate(f)
+			bicsRepo, i, err := loadBankData(*bankFile)
 			if err != nil {
 				return err
 			}
@@ -204,6 +224,35 @@ func Main() error {
 	return g.Run()
 }
 
+// loadBankData returns a BankRepo with the bank data of the file or the embedded bank data if the path is empty
+// and the number of its entries.
+// Banks without a rule in the IBAN rule column of the bank data get the IBAN rules that ship with iban-gen.
+func loadBankData(path string) (*bic.BankRepo, int, error) {
+	re := bic.NewBICRepo()
+	var i int
+	if path != "" {
+		var err error
+		i, err = re.PopulateFromFile(path)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to load bank data: %v", err)
+		}
+	} else {
+		f, err := bankData.Open(bundesbankFile)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer f.Close()
+		i, err = re.Populate(f)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	if _, err := re.PopulateDefaultRules(); err != nil {
+		return nil, 0, fmt.Errorf("failed to load IBAN rules: %v", err)
+	}
+	return re, i, nil
+}
+
 func main() {
 	if err := Main(); err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/leonnicolas/iban-gen/iban"
+	"github.com/leonnicolas/iban-gen/sortcode"
+)
+
+const (
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+
+	// keyEnv is the environment variable that holds the pseudonymization key
+	// if no key file is given, so that the key does not show up in the process list.
+	keyEnv = "IBAN_GEN_PSEUDONYMIZE_KEY"
+)
+
+// pseudonymize is the pseudonymize subcommand, which replaces the IBANs in a column
+// of a CSV or NDJSON file with their pseudonyms.
+func pseudonymize(args []string) error {
+	fs := flag.NewFlagSet("pseudonymize", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s pseudonymize -column <name> [flags] [file]\n\nReplaces the IBANs in a column of a CSV file with a header or in a field of an NDJSON file with pseudonyms and writes the result to stdout.\nPseudonyms keep the country and bank code and are the same for the same IBAN and key.\nThe key is read from the key file or the %s environment variable.\n\n", os.Args[0], keyEnv)
+		fs.PrintDefaults()
+	}
+	column := fs.String("column", "", "The name of the CSV column or NDJSON field that holds the IBANs.")
+	format := fs.String("format", "", fmt.Sprintf("The format of the input, %s or %s. Defaults to the file extension or %s.", formatCSV, formatNDJSON, formatCSV))
+	keyFile := fs.String("key-file", "", "The path to a file with the key.")
+	bankFile := fs.String("bank-file", "", "The path to a Bundesbank bank code file to use instead of the embedded one.")
+	sortCodeFile := fs.String("sort-code-file", "", "The path to a Vocalink sort code weight table to generate valid GB account numbers.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *column == "" {
+		return errors.New("a column is required")
+	}
+	if fs.NArg() > 1 {
+		return errors.New("at most one file can be pseudonymized at once")
+	}
+
+	key := []byte(os.Getenv(keyEnv))
+	if *keyFile != "" {
+		k, err := os.ReadFile(*keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read key: %v", err)
+		}
+		key = bytes.TrimSpace(k)
+	}
+	if *sortCodeFile != "" {
+		t := sortcode.NewTable()
+		if _, err := t.PopulateFromFile(*sortCodeFile); err != nil {
+			return fmt.Errorf("failed to load sort code table: %v", err)
+		}
+		iban.SetSortCodeTable(t)
+	}
+	banks, _, err := loadBankData(*bankFile)
+	if err != nil {
+		return err
+	}
+	p, err := iban.NewPseudonymizer(key, func(cc iban.CountryCode, bc string) string {
+		b, _ := banks.Bank(cc, bc)
+		return b.CheckMethod
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create pseudonymizer: %w; set %s or pass -key-file", err, keyEnv)
+	}
+
+	r := io.Reader(os.Stdin)
+	if fs.NArg() == 1 {
+		f, err := os.Open(fs.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+		if *format == "" {
+			switch strings.ToLower(filepath.Ext(f.Name())) {
+			case ".ndjson", ".jsonl":
+				*format = formatNDJSON
+			}
+		}
+	}
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	switch *format {
+	case "", formatCSV:
+		return pseudonymizeCSV(p, *column, r, w)
+	case formatNDJSON:
+		return pseudonymizeNDJSON(p, *column, r, w)
+	}
+	return fmt.Errorf("format %v unknown; possible values are: %s, %s", *format, formatCSV, formatNDJSON)
+}
+
+// pseudonymizeCSV replaces the IBANs in the column of a CSV file with a header.
+// Empty cells are kept.
+func pseudonymizeCSV(p *iban.Pseudonymizer, column string, r io.Reader, w io.Writer) error {
+	cr := csv.NewReader(r)
+	cw := csv.NewWriter(w)
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("failed to read header: %v", err)
+	}
+	c := -1
+	for i, h := range header {
+		if h == column {
+			c = i
+			break
+		}
+	}
+	if c < 0 {
+		return fmt.Errorf("column %q not found", column)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for line := 2; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if rec[c] != "" {
+			if rec[c], err = p.PseudonymizeString(rec[c]); err != nil {
+				return fmt.Errorf("line %d: %v", line, err)
+			}
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// pseudonymizeNDJSON replaces the IBANs in the field of the objects of an NDJSON file.
+// Missing fields, null and empty strings are kept. The fields of rewritten objects are sorted by name.
+func pseudonymizeNDJSON(p *iban.Pseudonymizer, field string, r io.Reader, w io.Writer) error {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 16*1024*1024)
+	for line := 1; s.Scan(); line++ {
+		l := s.Bytes()
+		var obj map[string]json.RawMessage
+		if len(bytes.TrimSpace(l)) > 0 {
+			if err := json.Unmarshal(l, &obj); err != nil {
+				return fmt.Errorf("line %d: %v", line, err)
+			}
+		}
+		var in *string
+		if v, ok := obj[field]; ok {
+			if err := json.Unmarshal(v, &in); err != nil {
+				return fmt.Errorf("line %d: field %q must be a string", line, field)
+			}
+		}
+		if in == nil || *in == "" {
+			if _, err := fmt.Fprintf(w, "%s\n", l); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := p.PseudonymizeString(*in)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", line, err)
+		}
+		if obj[field], err = json.Marshal(out); err != nil {
+			return err
+		}
+		l, err = json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", l); err != nil {
+			return err
+		}
+	}
+	return s.Err()
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+// scan is the scan subcommand, which finds IBANs in files or on stdin.
+func scan(args []string) error {
+	fs := flag.NewFlagSet("scan", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s scan [flags] [file...]\n\nFinds IBANs in the files or on stdin and prints their byte offset, the IBAN and whether it is valid.\n\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	onlyInvalid := fs.Bool("invalid", false, "Only print invalid IBANs.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+	if fs.NArg() == 0 {
+		return scanReader(w, "-", os.Stdin, *onlyInvalid)
+	}
+	for _, name := range fs.Args() {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = scanReader(w, name, f, *onlyInvalid)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanReader(w io.Writer, name string, r io.Reader, onlyInvalid bool) error {
+	s := iban.NewScanner(r)
+	for s.Scan() {
+		m := s.Match()
+		if m.Valid && onlyInvalid {
+			continue
+		}
+		status := "valid"
+		if !m.Valid {
+			status = m.Err.Error()
+		}
+		if _, err := fmt.Fprintf(w, "%s:%d\t%s\t%s\n", name, m.Offset, m.IBAN, status); err != nil {
+			return err
+		}
+	}
+	if err := s.Err(); err != nil {
+		return fmt.Errorf("failed to scan %s: %v", name, err)
+	}
+	return nil
+}
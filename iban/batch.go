@@ -0,0 +1,122 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrBatchTooLarge is returned if more distinct IBANs are requested than exist.
+var ErrBatchTooLarge = errors.New("more IBANs requested than distinct accounts exist")
+
+// maxCount is the maximum number of account numbers of a bank code that are checked one by one
+// to count its valid IBANs.
+const maxCount = 10000000
+
+// DistinctAccounts returns the number of distinct valid IBANs of the bank code of the country,
+// whose account numbers pass the national check digits and the check method of the bank.
+// They are counted exactly for bank codes with up to ten million account numbers. For larger ones, e.g. German banks,
+// and for an empty bank code, which stands for all banks of the country, the number of account numbers is returned
+// as an upper bound.
+func DistinctAccounts(cc CountryCode, bc, method string) (*big.Int, error) {
+	max, err := accountNumbers(cc, bc)
+	if err != nil || bc == "" || max.Cmp(big.NewInt(maxCount)) > 0 {
+		return max, err
+	}
+	c, err := countAccounts(cc, bc, method, maxCount)
+	return big.NewInt(c), err
+}
+
+// countAccounts counts the valid IBANs of the bank code of the country up to limit.
+func countAccounts(cc CountryCode, bc, method string, limit int64) (int64, error) {
+	e, err := NewEnumerator(cc, bc, method)
+	if err != nil {
+		return 0, err
+	}
+	var c int64
+	for c < limit && !e.done {
+		if _, ok := e.advance(); ok {
+			c++
+		}
+	}
+	return c, nil
+}
+
+// accountNumbers returns the number of account numbers of the bank code of the country
+// or of all banks of the country if the bank code is empty.
+func accountNumbers(cc CountryCode, bc string) (*big.Int, error) {
+	b, ok := countries[cc]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCountry, string(cc))
+	}
+	check := nationalCheckDigits[cc]
+	ret := big.NewInt(1)
+	for i := 0; i < len(b.pattern); i++ {
+		if bc != "" && i >= b.bankCode[0] && i < b.bankCode[1] {
+			continue
+		}
+		if i >= check[0] && i < check[1] {
+			continue
+		}
+		ret.Mul(ret, big.NewInt(int64(len(classChars(b.pattern[i])))))
+	}
+	return ret, nil
+}
+
+// CheckBatch returns ErrBatchTooLarge if fewer than n distinct valid IBANs exist for the bank code
+// and check method of the country like DistinctAccounts counts them,
+// and ErrUnknownCountry for unknown countries.
+// Counting stops after n IBANs, so that small batches of small bank codes are checked quickly.
+func CheckBatch(n int, cc CountryCode, bc, method string) error {
+	max, err := accountNumbers(cc, bc)
+	if err != nil {
+		return err
+	}
+	if max.Cmp(big.NewInt(int64(n))) >= 0 && bc != "" && max.Cmp(big.NewInt(maxCount)) <= 0 {
+		c, err := countAccounts(cc, bc, method, int64(n))
+		if err != nil {
+			return err
+		}
+		max = big.NewInt(c)
+	}
+	if max.Cmp(big.NewInt(int64(n))) < 0 {
+		s := strings.TrimSpace(fmt.Sprintf("%s %s", cc, bc))
+		return fmt.Errorf("%w: only %s valid IBANs exist for %s", ErrBatchTooLarge, max.String(), s)
+	}
+	return nil
+}
+
+// GenerateBatch generates n distinct IBANs for the bank code and check method like GenerateFromBankCodeAndMethod.
+// An empty bank code generates IBANs of random banks like GenerateForCountry.
+func (g *Generator) GenerateBatch(n int, cc CountryCode, bc, method string) ([]*IBAN, error) {
+	if err := CheckBatch(n, cc, bc, method); err != nil {
+		return nil, err
+	}
+	ret := make([]*IBAN, 0, n)
+	seen := make(map[string]struct{}, n)
+	for dups := 0; len(ret) < n; {
+		var i *IBAN
+		var err error
+		if bc == "" {
+			i, err = g.GenerateForCountry(cc)
+		} else {
+			i, err = g.GenerateFromBankCodeAndMethod(cc, bc, method)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[i.String()]; ok {
+			// Running into duplicates over and over means that the accounts are exhausted,
+			// e.g. because most of them fail the check method.
+			if dups++; dups > maxAttempts {
+				return nil, fmt.Errorf("%w: found only %d IBANs", ErrBatchTooLarge, len(ret))
+			}
+			continue
+		}
+		dups = 0
+		seen[i.String()] = struct{}{}
+		ret = append(ret, i)
+	}
+	return ret, nil
+}
@@ -0,0 +1,89 @@
+package iban
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestDistinctAccounts(t *testing.T) {
+	for _, tc := range []struct {
+		cc  CountryCode
+		bc  string
+		exp string
+	}{
+		{cc: "DE", bc: "37040044", exp: "10000000000"},
+		{cc: "DE", exp: "1000000000000000000"},
+		// About one in eleven Norwegian account numbers has no check digit.
+		{cc: "NO", bc: "8601", exp: "909091"},
+		{cc: "BE", bc: "539", exp: "10000000"},
+	} {
+		got, err := DistinctAccounts(tc.cc, tc.bc, "")
+		if err != nil {
+			t.Fatalf("%s %s: got err=%q\n", tc.cc, tc.bc, err.Error())
+		}
+		if got.String() != tc.exp {
+			t.Errorf("%s %s: got %s expected=%s\n", tc.cc, tc.bc, got, tc.exp)
+		}
+	}
+	if _, err := DistinctAccounts("XX", "", ""); !errors.Is(err, ErrUnknownCountry) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrUnknownCountry)
+	}
+}
+
+func TestCheckBatch(t *testing.T) {
+	for _, tc := range []struct {
+		n      int
+		cc     CountryCode
+		bc     string
+		method string
+		err    error
+	}{
+		{n: 909091, cc: "NO", bc: "8601"},
+		{n: 909092, cc: "NO", bc: "8601", err: ErrBatchTooLarge},
+		{n: 1000000, cc: "DE", bc: "37040044", method: "13"},
+		{n: 1, cc: "XX", err: ErrUnknownCountry},
+	} {
+		if err := CheckBatch(tc.n, tc.cc, tc.bc, tc.method); !errors.Is(err, tc.err) {
+			t.Errorf("%d %s %s: got err=%v expected=%v\n", tc.n, tc.cc, tc.bc, err, tc.err)
+		}
+	}
+}
+
+func TestGenerateBatch(t *testing.T) {
+	g := NewGenerator(rand.NewSource(1))
+	for _, tc := range []struct {
+		n      int
+		cc     CountryCode
+		bc     string
+		method string
+		err    error
+	}{
+		{n: 1000, cc: "DE", bc: "37040044", method: "00"},
+		{n: 1000, cc: "GB"},
+		{n: 100, cc: "FR", bc: "3000600001"},
+		{n: 1000001, cc: "NO", bc: "8601", err: ErrBatchTooLarge},
+		{n: 910000, cc: "NO", bc: "8601", err: ErrBatchTooLarge},
+	} {
+		is, err := g.GenerateBatch(tc.n, tc.cc, tc.bc, tc.method)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("%s %s: got err=%v expected=%v\n", tc.cc, tc.bc, err, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		if len(is) != tc.n {
+			t.Errorf("%s %s: got %d IBANs expected=%d\n", tc.cc, tc.bc, len(is), tc.n)
+		}
+		seen := make(map[string]bool)
+		for _, i := range is {
+			if seen[i.String()] {
+				t.Errorf("%s %s: got duplicate %s\n", tc.cc, tc.bc, i.String())
+			}
+			seen[i.String()] = true
+			if _, err := Parse(i.String()); err != nil {
+				t.Errorf("%s: got err=%q\n", i.String(), err.Error())
+			}
+		}
+	}
+}
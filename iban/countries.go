@@ -0,0 +1,228 @@
+package iban
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Character classes of a BBAN position as used by the SWIFT IBAN registry.
+const (
+	classDigit    = 'n'
+	classLetter   = 'a'
+	classAlphaNum = 'c'
+)
+
+// bban describes the structure of the Basic Bank Account Number of a country
+// as published in the SWIFT IBAN registry.
+type bban struct {
+	// length is the length of the whole IBAN.
+	length int
+	// format is the BBAN structure in registry notation, e.g. "8n10n".
+	format string
+	// bankCode is the [start, end) position of the bank and branch identifier in the BBAN.
+	bankCode [2]int
+	// pattern holds the character class of every BBAN position.
+	pattern string
+}
+
+var countries = map[CountryCode]*bban{
+	"AD": {length: 24, format: "4n4n12c", bankCode: [2]int{0, 8}},
+	"AE": {length: 23, format: "3n16n", bankCode: [2]int{0, 3}},
+	"AL": {length: 28, format: "8n16c", bankCode: [2]int{0, 8}},
+	"AT": {length: 20, format: "5n11n", bankCode: [2]int{0, 5}},
+	"AZ": {length: 28, format: "4a20c", bankCode: [2]int{0, 4}},
+	"BA": {length: 20, format: "3n3n8n2n", bankCode: [2]int{0, 6}},
+	"BE": {length: 16, format: "3n7n2n", bankCode: [2]int{0, 3}},
+	"BG": {length: 22, format: "4a4n2n8c", bankCode: [2]int{0, 8}},
+	"BH": {length: 22, format: "4a14c", bankCode: [2]int{0, 4}},
+	"BI": {length: 27, format: "5n5n11n2n", bankCode: [2]int{0, 10}},
+	"BR": {length: 29, format: "8n5n10n1a1c", bankCode: [2]int{0, 13}},
+	"BY": {length: 28, format: "4c4n16c", bankCode: [2]int{0, 4}},
+	"CH": {length: 21, format: "5n12c", bankCode: [2]int{0, 5}},
+	"CR": {length: 22, format: "4n14n", bankCode: [2]int{0, 4}},
+	"CY": {length: 28, format: "3n5n16c", bankCode: [2]int{0, 8}},
+	"CZ": {length: 24, format: "4n6n10n", bankCode: [2]int{0, 4}},
+	"DE": {length: 22, format: "8n10n", bankCode: [2]int{0, 8}},
+	"DJ": {length: 27, format: "5n5n11n2n", bankCode: [2]int{0, 10}},
+	"DK": {length: 18, format: "4n9n1n", bankCode: [2]int{0, 4}},
+	"DO": {length: 28, format: "4c20n", bankCode: [2]int{0, 4}},
+	"EE": {length: 20, format: "2n2n11n1n", bankCode: [2]int{0, 2}},
+	"EG": {length: 29, format: "4n4n17n", bankCode: [2]int{0, 8}},
+	"ES": {length: 24, format: "4n4n1n1n10n", bankCode: [2]int{0, 8}},
+	"FI": {length: 18, format: "3n11n", bankCode: [2]int{0, 3}},
+	"FK": {length: 18, format: "2a12n", bankCode: [2]int{0, 2}},
+	"FO": {length: 18, format: "4n9n1n", bankCode: [2]int{0, 4}},
+	"FR": {length: 27, format: "5n5n11c2n", bankCode: [2]int{0, 10}},
+	"GB": {length: 22, format: "4a6n8n", bankCode: [2]int{0, 10}},
+	"GE": {length: 22, format: "2a16n", bankCode: [2]int{0, 2}},
+	"GI": {length: 23, format: "4a15c", bankCode: [2]int{0, 4}},
+	"GL": {length: 18, format: "4n9n1n", bankCode: [2]int{0, 4}},
+	"GR": {length: 27, format: "3n4n16c", bankCode: [2]int{0, 7}},
+	"GT": {length: 28, format: "4c20c", bankCode: [2]int{0, 4}},
+	"HR": {length: 21, format: "7n10n", bankCode: [2]int{0, 7}},
+	"HU": {length: 28, format: "3n4n1n15n1n", bankCode: [2]int{0, 7}},
+	"IE": {length: 22, format: "4a6n8n", bankCode: [2]int{0, 10}},
+	"IL": {length: 23, format: "3n3n13n", bankCode: [2]int{0, 6}},
+	"IQ": {length: 23, format: "4a3n12n", bankCode: [2]int{0, 7}},
+	"IS": {length: 26, format: "4n2n6n10n", bankCode: [2]int{0, 4}},
+	"IT": {length: 27, format: "1a5n5n12c", bankCode: [2]int{1, 11}},
+	"JO": {length: 30, format: "4a4n18c", bankCode: [2]int{0, 8}},
+	"KW": {length: 30, format: "4a22c", bankCode: [2]int{0, 4}},
+	"KZ": {length: 20, format: "3n13c", bankCode: [2]int{0, 3}},
+	"LB": {length: 28, format: "4n20c", bankCode: [2]int{0, 4}},
+	"LC": {length: 32, format: "4a24c", bankCode: [2]int{0, 4}},
+	"LI": {length: 21, format: "5n12c", bankCode: [2]int{0, 5}},
+	"LT": {length: 20, format: "5n11n", bankCode: [2]int{0, 5}},
+	"LU": {length: 20, format: "3n13c", bankCode: [2]int{0, 3}},
+	"LV": {length: 21, format: "4a13c", bankCode: [2]int{0, 4}},
+	"LY": {length: 25, format: "3n3n15n", bankCode: [2]int{0, 6}},
+	"MC": {length: 27, format: "5n5n11c2n", bankCode: [2]int{0, 10}},
+	"MD": {length: 24, format: "2c18c", bankCode: [2]int{0, 2}},
+	"ME": {length: 22, format: "3n13n2n", bankCode: [2]int{0, 3}},
+	"MK": {length: 19, format: "3n10c2n", bankCode: [2]int{0, 3}},
+	"MN": {length: 20, format: "4n12n", bankCode: [2]int{0, 4}},
+	"MR": {length: 27, format: "5n5n11n2n", bankCode: [2]int{0, 10}},
+	"MT": {length: 31, format: "4a5n18c", bankCode: [2]int{0, 9}},
+	"MU": {length: 30, format: "4a2n2n12n3n3a", bankCode: [2]int{0, 8}},
+	"NI": {length: 28, format: "4a20n", bankCode: [2]int{0, 4}},
+	"NL": {length: 18, format: "4a10n", bankCode: [2]int{0, 4}},
+	"NO": {length: 15, format: "4n6n1n", bankCode: [2]int{0, 4}},
+	"OM": {length: 23, format: "3n16c", bankCode: [2]int{0, 3}},
+	"PK": {length: 24, format: "4a16c", bankCode: [2]int{0, 4}},
+	"PL": {length: 28, format: "8n16n", bankCode: [2]int{0, 8}},
+	"PS": {length: 29, format: "4a21c", bankCode: [2]int{0, 4}},
+	"PT": {length: 25, format: "4n4n11n2n", bankCode: [2]int{0, 8}},
+	"QA": {length: 29, format: "4a21c", bankCode: [2]int{0, 4}},
+	"RO": {length: 24, format: "4a16c", bankCode: [2]int{0, 4}},
+	"RS": {length: 22, format: "3n13n2n", bankCode: [2]int{0, 3}},
+	"RU": {length: 33, format: "9n5n15c", bankCode: [2]int{0, 14}},
+	"SA": {length: 24, format: "2n18c", bankCode: [2]int{0, 2}},
+	"SC": {length: 31, format: "4a2n2n16n3a", bankCode: [2]int{0, 8}},
+	"SD": {length: 18, format: "2n12n", bankCode: [2]int{0, 2}},
+	"SE": {length: 24, format: "3n16n1n", bankCode: [2]int{0, 3}},
+	"SI": {length: 19, format: "5n8n2n", bankCode: [2]int{0, 5}},
+	"SK": {length: 24, format: "4n6n10n", bankCode: [2]int{0, 4}},
+	"SM": {length: 27, format: "1a5n5n12c", bankCode: [2]int{1, 11}},
+	"SO": {length: 23, format: "4n3n12n", bankCode: [2]int{0, 7}},
+	"ST": {length: 25, format: "4n4n11n2n", bankCode: [2]int{0, 8}},
+	"SV": {length: 28, format: "4a20n", bankCode: [2]int{0, 4}},
+	"TL": {length: 23, format: "3n14n2n", bankCode: [2]int{0, 3}},
+	"TN": {length: 24, format: "2n3n13n2n", bankCode: [2]int{0, 5}},
+	"TR": {length: 26, format: "5n1n16c", bankCode: [2]int{0, 5}},
+	"UA": {length: 29, format: "6n19c", bankCode: [2]int{0, 6}},
+	"VA": {length: 22, format: "3n15n", bankCode: [2]int{0, 3}},
+	"VG": {length: 24, format: "4a16n", bankCode: [2]int{0, 4}},
+	"XK": {length: 20, format: "4n10n2n", bankCode: [2]int{0, 4}},
+	"YE": {length: 30, format: "4a4n18c", bankCode: [2]int{0, 8}},
+}
+
+func init() {
+	for cc, b := range countries {
+		p, err := expandFormat(b.format)
+		if err != nil {
+			panic(fmt.Sprintf("invalid BBAN format for %s: %v", cc, err))
+		}
+		b.pattern = p
+	}
+}
+
+// CountryCodes returns all supported country codes in alphabetical order.
+func CountryCodes() []CountryCode {
+	ret := make([]CountryCode, 0, len(countries))
+	for cc := range countries {
+		ret = append(ret, cc)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
+// Length returns the length of an IBAN of the country or 0 if the country is not supported.
+func (c CountryCode) Length() int {
+	if b, ok := countries[c]; ok {
+		return b.length
+	}
+	return 0
+}
+
+// BankCodeLength returns the length of a bank code of the country or 0 if the country is not supported.
+func (c CountryCode) BankCodeLength() int {
+	if b, ok := countries[c]; ok {
+		return b.bankCode[1] - b.bankCode[0]
+	}
+	return 0
+}
+
+// expandFormat turns a registry format like "4a6n" into one character class per position.
+func expandFormat(f string) (string, error) {
+	var sb strings.Builder
+	for len(f) > 0 {
+		i := strings.IndexAny(f, "nac")
+		if i < 1 {
+			return "", fmt.Errorf("malformed format %q", f)
+		}
+		n, err := strconv.Atoi(f[:i])
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(strings.Repeat(f[i:i+1], n))
+		f = f[i+1:]
+	}
+	return sb.String(), nil
+}
+
+// bankPattern returns the character classes of the bank code.
+func (b *bban) bankPattern() string {
+	return b.pattern[b.bankCode[0]:b.bankCode[1]]
+}
+
+// accountPattern returns the character classes of the BBAN without the bank code.
+func (b *bban) accountPattern() string {
+	return b.pattern[:b.bankCode[0]] + b.pattern[b.bankCode[1]:]
+}
+
+// join assembles the BBAN from a bank code and an account number.
+func (b *bban) join(bc, aNo string) string {
+	if b.bankCode[0] > len(aNo) {
+		return bc + aNo
+	}
+	return aNo[:b.bankCode[0]] + bc + aNo[b.bankCode[0]:]
+}
+
+// split splits the BBAN into a bank code and an account number.
+func (b *bban) split(s string) (string, string) {
+	return s[b.bankCode[0]:b.bankCode[1]], s[:b.bankCode[0]] + s[b.bankCode[1]:]
+}
+
+// digitPattern returns a pattern of n digits.
+func digitPattern(n int) string {
+	return strings.Repeat(string(classDigit), n)
+}
+
+// matches reports whether s is a valid string for the pattern p.
+func matches(p, s string) bool {
+	if len(p) != len(s) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !inClass(p[i], s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func inClass(class, c byte) bool {
+	isDigit := c >= '0' && c <= '9'
+	isLetter := c >= 'A' && c <= 'Z'
+	switch class {
+	case classDigit:
+		return isDigit
+	case classLetter:
+		return isLetter
+	case classAlphaNum:
+		return isDigit || isLetter
+	}
+	return false
+}
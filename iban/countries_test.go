@@ -0,0 +1,72 @@
+package iban
+
+import (
+	"testing"
+)
+
+func TestCountries(t *testing.T) {
+	for cc, b := range countries {
+		if l := len(b.pattern) + 4; l != b.length {
+			t.Errorf("%s: format %q results in length %d, expected %d\n", cc, b.format, l, b.length)
+		}
+		if b.bankCode[0] >= b.bankCode[1] || b.bankCode[1] > len(b.pattern) {
+			t.Errorf("%s: invalid bank code position %v\n", cc, b.bankCode)
+		}
+	}
+}
+
+func TestCheckCountries(t *testing.T) {
+	for _, tc := range []struct {
+		in  IBAN
+		out string
+	}{
+		{
+			in:  IBAN{bc: "WEST123456", aNo: "98765432", cc: "GB"},
+			out: "GB82WEST12345698765432",
+		},
+		{
+			in:  IBAN{bc: "ABNA", aNo: "0417164300", cc: "NL"},
+			out: "NL91ABNA0417164300",
+		},
+		{
+			in:  IBAN{bc: "2004101005", aNo: "0500013M02606", cc: "FR"},
+			out: "FR1420041010050500013M02606",
+		},
+		{
+			in:  IBAN{bc: "0542811101", aNo: "X000000123456", cc: "IT"},
+			out: "IT60X0542811101000000123456",
+		},
+		{
+			in:  IBAN{bc: "37040044", aNo: "0532013000", cc: CountryCodeDE},
+			out: "DE89370400440532013000",
+		},
+	} {
+		out, err := tc.in.check()
+		if err != nil {
+			t.Errorf("%s: got err=%q\n", tc.out, err.Error())
+			continue
+		}
+		if out.String() != tc.out {
+			t.Errorf("got=%q expected=%q\n", out.String(), tc.out)
+		}
+	}
+}
+
+func TestGenerateForCountry(t *testing.T) {
+	for _, cc := range CountryCodes() {
+		i, err := GenerateForCountry(cc)
+		if err != nil {
+			t.Errorf("%s: got err=%q\n", cc, err.Error())
+			continue
+		}
+		if l := len(i.String()); l != cc.Length() {
+			t.Errorf("%s: got length=%d expected=%d\n", cc, l, cc.Length())
+		}
+		if !matches(countries[cc].pattern, i.BBAN()) {
+			t.Errorf("%s: BBAN %q does not match %q\n", cc, i.BBAN(), countries[cc].format)
+		}
+	}
+	if _, err := GenerateForCountry("XX"); err == nil {
+		t.Errorf("expected an error for an unsupported country\n")
+	}
+}
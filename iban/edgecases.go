@@ -0,0 +1,133 @@
+package iban
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EdgeCase is a valid IBAN at a boundary of the IBAN structure of a country.
+type EdgeCase struct {
+	// Name identifies the kind of edge case, e.g. "checkDigits02".
+	Name string
+	// Description explains what is special about the IBAN.
+	Description string
+	IBAN        *IBAN
+}
+
+// EdgeCases returns a deterministic set of edge cases for the country.
+// The known bank codes, e.g. from bank data, are preferred over bank codes derived from the BBAN structure.
+// Edge cases that do not exist for the country are left out, e.g. letter-heavy BBANs for countries without
+// letters or the check digits 02 and 98 for France, whose national check digits fix the check digits of the IBAN.
+func EdgeCases(cc CountryCode, bankCodes []string) ([]EdgeCase, error) {
+	b, ok := countries[cc]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCountry, string(cc))
+	}
+	var known []string
+	for _, bc := range bankCodes {
+		if matches(b.bankPattern(), bc) {
+			known = append(known, bc)
+		}
+	}
+	bc := fill(b.bankPattern(), '1', 'A')
+	if len(known) > 0 {
+		bc = known[0]
+	}
+	var ret []EdgeCase
+	add := func(name, desc, bban string) {
+		if bban, ok := withNationalCheckDigits(cc, bban); ok {
+			i, err := Parse(withCheckDigits(cc, bban))
+			if err != nil {
+				return
+			}
+			ret = append(ret, EdgeCase{Name: name, Description: desc, IBAN: i})
+		}
+	}
+	for _, c := range []struct{ cs, desc string }{
+		{cs: "02", desc: "The smallest possible check digits 02."},
+		{cs: "98", desc: "The largest possible check digits 98."},
+	} {
+		if bban, ok := withCheckDigitsOf(cc, b.join(bc, fill(b.accountPattern(), '1', 'A')), c.cs); ok {
+			add("checkDigits"+c.cs, c.desc, bban)
+		}
+	}
+	add("zeroAccountNo", "The account number consists of zeros apart from national check digits.", b.join(bc, fill(b.accountPattern(), '0', 'A')))
+	add("nineAccountNo", "The account number consists of nines apart from national check digits.", b.join(bc, fill(b.accountPattern(), '9', 'Z')))
+	if strings.ContainsAny(b.pattern, string([]byte{classLetter, classAlphaNum})) {
+		add("letterHeavy", "Every position of the BBAN that allows letters is a letter.", letterHeavy(b.pattern))
+	}
+	if p := b.bankPattern(); p[0] != classLetter {
+		zbc := fill(p[:len(p)-1], '0', 'A') + fill(p[len(p)-1:], '1', 'A')
+		for _, k := range known {
+			if k[0] == '0' {
+				zbc = k
+				break
+			}
+		}
+		add("leadingZeroBankCode", "The bank code starts with a zero.", b.join(zbc, fill(b.accountPattern(), '1', 'A')))
+	}
+	if b.length == maxLength() {
+		add("longest", fmt.Sprintf("The country has the longest IBANs of the registry with %d characters.", b.length), b.join(bc, fill(b.accountPattern(), '1', 'A')))
+	}
+	return ret, nil
+}
+
+// fill returns a string for the pattern with the digit for numeric and the letter for alphabetic positions.
+func fill(p string, digit, letter byte) string {
+	ret := make([]byte, len(p))
+	for i := range ret {
+		ret[i] = digit
+		if p[i] == classLetter {
+			ret[i] = letter
+		}
+	}
+	return string(ret)
+}
+
+// letterHeavy returns a string for the pattern with letters at every position that allows them.
+func letterHeavy(p string) string {
+	ret := make([]byte, len(p))
+	for i := range ret {
+		ret[i] = '0'
+		if p[i] != classDigit {
+			ret[i] = letters[i%len(letters)]
+		}
+	}
+	return string(ret)
+}
+
+// maxLength returns the length of the longest IBANs of the registry.
+func maxLength() int {
+	max := 0
+	for _, b := range countries {
+		if b.length > max {
+			max = b.length
+		}
+	}
+	return max
+}
+
+// withCheckDigitsOf changes the trailing digits of the account number of the BBAN
+// until the IBAN has the check digits cs.
+func withCheckDigitsOf(cc CountryCode, bban, cs string) (string, bool) {
+	b := countries[cc]
+	check := nationalCheckDigits[cc]
+	// Only the last four positions of the account number that allow digits are changed.
+	var pos []int
+	for i := len(b.pattern) - 1; i >= 0 && len(pos) < 4; i-- {
+		if (i >= b.bankCode[0] && i < b.bankCode[1]) || (i >= check[0] && i < check[1]) || b.pattern[i] == classLetter {
+			continue
+		}
+		pos = append(pos, i)
+	}
+	s := []byte(bban)
+	for n := 0; n < maxAttempts; n++ {
+		for i, m := 0, n; i < len(pos); i, m = i+1, m/10 {
+			s[pos[i]] = byte('0' + m%10)
+		}
+		if bban, ok := withNationalCheckDigits(cc, string(s)); ok && withCheckDigits(cc, bban)[2:4] == cs {
+			return bban, true
+		}
+	}
+	return "", false
+}
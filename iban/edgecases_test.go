@@ -0,0 +1,49 @@
+package iban
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEdgeCases(t *testing.T) {
+	for _, cc := range CountryCodes() {
+		ecs, err := EdgeCases(cc, nil)
+		if err != nil {
+			t.Fatalf("%s: got err=%q\n", cc, err.Error())
+		}
+		names := make(map[string]*IBAN)
+		for _, ec := range ecs {
+			if _, err := Parse(ec.IBAN.String()); err != nil {
+				t.Errorf("%s %s: got err=%q\n", ec.IBAN.String(), ec.Name, err.Error())
+			}
+			names[ec.Name] = ec.IBAN
+		}
+		for _, name := range []string{"checkDigits02", "checkDigits98", "zeroAccountNo", "nineAccountNo"} {
+			// The national check digits of FR, MC and PT fix the check digits of the IBAN.
+			if _, ok := names[name]; !ok && !strings.HasPrefix(name, "checkDigits") {
+				t.Errorf("%s: missing edge case %s\n", cc, name)
+			}
+		}
+		if _, ok := names["checkDigits02"]; !ok && cc != "FR" && cc != "MC" && cc != "PT" {
+			t.Errorf("%s: missing edge case checkDigits02\n", cc)
+		}
+		if i, ok := names["checkDigits02"]; ok && i.CheckDigits() != "02" {
+			t.Errorf("%s: got check digits %s expected=02\n", i.String(), i.CheckDigits())
+		}
+		if i, ok := names["leadingZeroBankCode"]; ok && !strings.HasPrefix(i.BankCode(), "0") {
+			t.Errorf("%s: got bank code %s without leading zero\n", i.String(), i.BankCode())
+		}
+	}
+	ecs, _ := EdgeCases("DE", []string{"37040044", "01234567"})
+	for _, ec := range ecs {
+		if ec.Name == "leadingZeroBankCode" && ec.IBAN.BankCode() != "01234567" {
+			t.Errorf("%s: expected the known bank code 01234567\n", ec.IBAN.String())
+		}
+		if ec.Name == "zeroAccountNo" && ec.IBAN.String() != "DE68370400440000000000" {
+			t.Errorf("got %s expected=DE68370400440000000000\n", ec.IBAN.String())
+		}
+	}
+	if _, err := EdgeCases("XX", nil); err == nil {
+		t.Errorf("expected an error for XX\n")
+	}
+}
@@ -0,0 +1,80 @@
+package iban
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns the electronic format of the IBAN and an empty text for the zero IBAN.
+func (i IBAN) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It parses and validates the IBAN like Parse. An empty text is the zero IBAN.
+func (i *IBAN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*i = IBAN{}
+		return nil
+	}
+	p, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*i = *p
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The IBAN is encoded as a JSON string of its electronic format and the zero IBAN as JSON null,
+// so that it is decoded to the zero IBAN again.
+func (i IBAN) MarshalJSON() ([]byte, error) {
+	if i == (IBAN{}) {
+		return []byte("null"), nil
+	}
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It validates the IBAN like Parse, so an empty string is an error.
+// JSON null leaves the IBAN unchanged, which is the zero IBAN for a new value.
+func (i *IBAN) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		// Only null is the zero IBAN in JSON.
+		return ErrInvalidLength
+	}
+	return i.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface.
+// The zero IBAN is stored as NULL.
+func (i IBAN) Value() (driver.Value, error) {
+	if i == (IBAN{}) {
+		return nil, nil
+	}
+	return i.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// It validates the IBAN like Parse. NULL is scanned into the zero IBAN, which Value stores as NULL.
+func (i *IBAN) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return i.UnmarshalText([]byte(v))
+	case []byte:
+		return i.UnmarshalText(v)
+	case nil:
+		*i = IBAN{}
+		return nil
+	}
+	return fmt.Errorf("cannot scan %T into an IBAN", src)
+}
@@ -0,0 +1,119 @@
+package iban
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = IBAN{}
+	_ encoding.TextUnmarshaler = &IBAN{}
+	_ json.Marshaler           = IBAN{}
+	_ json.Unmarshaler         = &IBAN{}
+	_ driver.Valuer            = IBAN{}
+	_ sql.Scanner              = &IBAN{}
+)
+
+func TestJSON(t *testing.T) {
+	type account struct {
+		IBAN  IBAN  `json:"iban"`
+		Other *IBAN `json:"other"`
+	}
+	in := `{"iban":"DE89370400440532013000","other":null}`
+	var a account
+	if err := json.Unmarshal([]byte(in), &a); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if a.IBAN.String() != "DE89370400440532013000" || a.Other != nil {
+		t.Errorf("got %+v\n", a)
+	}
+	out, err := json.Marshal(a)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if string(out) != in {
+		t.Errorf("got %s expected=%s\n", out, in)
+	}
+	// The zero IBAN is encoded as null and decoded to the zero IBAN again.
+	out, err = json.Marshal(account{})
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if string(out) != `{"iban":null,"other":null}` {
+		t.Errorf("got %s\n", out)
+	}
+	var zero account
+	if err := json.Unmarshal(out, &zero); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if zero != (account{}) {
+		t.Errorf("got %+v for the zero IBAN\n", zero)
+	}
+	for _, tc := range []struct {
+		in  string
+		err error
+	}{
+		{in: `{"iban":"DE88370400440532013000"}`, err: ErrInvalidChecksum},
+		{in: `{"iban":""}`, err: ErrInvalidLength},
+		{in: `{"iban":12}`},
+	} {
+		err := json.Unmarshal([]byte(tc.in), &a)
+		if err == nil || (tc.err != nil && !errors.Is(err, tc.err)) {
+			t.Errorf("%s: got err=%v expected=%v\n", tc.in, err, tc.err)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	var i IBAN
+	if err := i.UnmarshalText([]byte("GB82WEST12345698765432")); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	out, _ := i.MarshalText()
+	if string(out) != "GB82WEST12345698765432" {
+		t.Errorf("got %s\n", out)
+	}
+	if err := i.UnmarshalText([]byte("GB82WEST1234569876543")); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrInvalidLength)
+	}
+	// The zero IBAN is an empty text and an empty text is the zero IBAN.
+	out, _ = IBAN{}.MarshalText()
+	if err := i.UnmarshalText(out); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if i != (IBAN{}) {
+		t.Errorf("got %+v for the zero IBAN\n", i)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	var i IBAN
+	if v, err := i.Value(); v != nil || err != nil {
+		t.Errorf("got %v and err=%v for the zero IBAN\n", v, err)
+	}
+	for _, src := range []interface{}{"DE89370400440532013000", []byte("DE89370400440532013000")} {
+		if err := i.Scan(src); err != nil {
+			t.Fatalf("%v: got err=%q\n", src, err.Error())
+		}
+		if v, _ := i.Value(); v != "DE89370400440532013000" {
+			t.Errorf("got %v\n", v)
+		}
+	}
+	// The zero IBAN is stored as NULL and NULL is scanned into the zero IBAN.
+	v, _ := IBAN{}.Value()
+	if err := i.Scan(v); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if i != (IBAN{}) {
+		t.Errorf("got %+v for the zero IBAN\n", i)
+	}
+	for _, src := range []interface{}{12, "DE88370400440532013000"} {
+		if err := i.Scan(src); err == nil {
+			t.Errorf("%v: expected an error\n", src)
+		}
+	}
+}
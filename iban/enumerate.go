@@ -0,0 +1,162 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidCursor is returned for cursors that do not belong to an Enumerator.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Enumerator walks through the valid IBANs of a bank code in ascending order of their account numbers.
+// It is the sequential counterpart to GenerateFromBankCode.
+type Enumerator struct {
+	cc     CountryCode
+	method string
+	// bban is the BBAN with the bank code and placeholders for the account number.
+	bban []byte
+	// pos contains the enumerated positions of the BBAN with the most significant first.
+	pos []int
+	// idx contains the index of the next character of every position.
+	idx  []int
+	done bool
+}
+
+// NewEnumerator returns an Enumerator for the bank code of the country.
+// If the method is a check method of the Deutsche Bundesbank, German account numbers that fail it are skipped;
+// ErrUnsupportedMethod is returned for methods that are not implemented.
+func NewEnumerator(cc CountryCode, bc, method string) (*Enumerator, error) {
+	b, ok := countries[cc]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCountry, string(cc))
+	}
+	if cc == CountryCodeDE {
+		if _, err := checked(method); err != nil {
+			return nil, err
+		}
+	}
+	if !matches(b.bankPattern(), bc) {
+		return nil, fmt.Errorf("bank code %q does not match the structure %s of %s", bc, b.format, string(cc))
+	}
+	e := &Enumerator{
+		cc:     cc,
+		method: method,
+		bban:   []byte(b.join(bc, fill(b.accountPattern(), '0', 'A'))),
+	}
+	check := nationalCheckDigits[cc]
+	for i := range b.pattern {
+		if (i >= b.bankCode[0] && i < b.bankCode[1]) || (i >= check[0] && i < check[1]) {
+			continue
+		}
+		e.pos = append(e.pos, i)
+	}
+	e.idx = make([]int, len(e.pos))
+	return e, nil
+}
+
+// maxScan is the maximum number of account numbers that Next tries,
+// so that sparse check methods do not block it for hours.
+const maxScan = 100000
+
+// Next returns the next valid IBAN.
+// It returns false if all IBANs were returned or if no valid IBAN was found within maxScan account numbers.
+// Done tells both cases apart; in the latter Next can be called again to continue.
+func (e *Enumerator) Next() (*IBAN, bool) {
+	for n := 0; n < maxScan && !e.done; n++ {
+		bban, ok := e.advance()
+		if !ok {
+			continue
+		}
+		i, err := Parse(withCheckDigits(e.cc, bban))
+		if err != nil {
+			continue
+		}
+		return i, true
+	}
+	return nil, false
+}
+
+// advance returns the current BBAN with national check digits, moves the Enumerator to the next account number
+// and reports whether the BBAN is valid.
+func (e *Enumerator) advance() (string, bool) {
+	s := e.current()
+	e.increment()
+	bban, ok := withNationalCheckDigits(e.cc, s)
+	if !ok {
+		return "", false
+	}
+	if ok, _ := checked(e.method); ok && e.cc == CountryCodeDE {
+		if bc, aNo := countries[e.cc].split(bban); CheckBankAccountNo(e.method, bc, aNo) != nil {
+			return "", false
+		}
+	}
+	return bban, true
+}
+
+// Done reports whether all IBANs were returned.
+func (e *Enumerator) Done() bool {
+	return e.done
+}
+
+// Cursor returns the position of the Enumerator, which can be passed to Seek to resume later.
+// The cursor is empty if all IBANs were returned.
+func (e *Enumerator) Cursor() string {
+	if e.done {
+		return ""
+	}
+	ret := make([]byte, len(e.pos))
+	for i, p := range e.pos {
+		ret[i] = classChars(countries[e.cc].pattern[p])[e.idx[i]]
+	}
+	return string(ret)
+}
+
+// Seek moves the Enumerator to the position of a cursor.
+// An empty cursor moves it to the beginning.
+func (e *Enumerator) Seek(cursor string) error {
+	if cursor == "" {
+		e.idx = make([]int, len(e.pos))
+		e.done = false
+		return nil
+	}
+	if len(cursor) != len(e.pos) {
+		return ErrInvalidCursor
+	}
+	idx := make([]int, len(e.pos))
+	for i, p := range e.pos {
+		chars := classChars(countries[e.cc].pattern[p])
+		j := -1
+		for k := range chars {
+			if chars[k] == cursor[i] {
+				j = k
+			}
+		}
+		if j < 0 {
+			return ErrInvalidCursor
+		}
+		idx[i] = j
+	}
+	e.idx = idx
+	e.done = false
+	return nil
+}
+
+// current returns the BBAN at the position of the Enumerator.
+func (e *Enumerator) current() string {
+	for i, p := range e.pos {
+		e.bban[p] = classChars(countries[e.cc].pattern[p])[e.idx[i]]
+	}
+	return string(e.bban)
+}
+
+// increment moves the Enumerator to the next account number.
+func (e *Enumerator) increment() {
+	for i := len(e.pos) - 1; i >= 0; i-- {
+		e.idx[i]++
+		if e.idx[i] < len(classChars(countries[e.cc].pattern[e.pos[i]])) {
+			return
+		}
+		e.idx[i] = 0
+	}
+	e.done = true
+}
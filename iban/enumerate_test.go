@@ -0,0 +1,88 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEnumerator(t *testing.T) {
+	e, err := NewEnumerator(CountryCodeDE, "37040044", "")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	for _, exp := range []string{"DE68370400440000000000", "DE41370400440000000001", "DE14370400440000000002"} {
+		i, ok := e.Next()
+		if !ok || i.String() != exp {
+			t.Errorf("got %v expected=%s\n", i, exp)
+		}
+	}
+	if c := e.Cursor(); c != "0000000003" {
+		t.Errorf("got cursor %q expected=%q\n", c, "0000000003")
+	}
+
+	// Resuming with a cursor continues where the last Enumerator stopped.
+	e1, _ := NewEnumerator(CountryCodeDE, "37040044", "13")
+	var last *IBAN
+	for n := 0; n < 5; n++ {
+		last, _ = e1.Next()
+		if err := CheckAccountNo("13", last.AccountNo()); err != nil {
+			t.Errorf("%s: got err=%q\n", last.String(), err.Error())
+		}
+	}
+	e2, _ := NewEnumerator(CountryCodeDE, "37040044", "13")
+	if err := e2.Seek(e1.Cursor()); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	i1, _ := e1.Next()
+	i2, _ := e2.Next()
+	if i1.String() != i2.String() || i1.AccountNo() <= last.AccountNo() {
+		t.Errorf("got %s and %s after %s\n", i1.String(), i2.String(), last.String())
+	}
+
+	for _, c := range []string{"000000000", "00000000A0"} {
+		if err := e2.Seek(c); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%q: got err=%v expected=%v\n", c, err, ErrInvalidCursor)
+		}
+	}
+	if _, err := NewEnumerator(CountryCodeDE, "3704004", ""); err == nil {
+		t.Errorf("expected an error for a short bank code\n")
+	}
+}
+
+func TestEnumeratorEnd(t *testing.T) {
+	// Norwegian account numbers have six digits and a national check digit.
+	e, _ := NewEnumerator("NO", "8601", "")
+	if err := e.Seek("999990"); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	n := 0
+	for i, ok := e.Next(); ok; i, ok = e.Next() {
+		if _, err := Parse(i.String()); err != nil {
+			t.Errorf("%s: got err=%q\n", i.String(), err.Error())
+		}
+		n++
+	}
+	if n == 0 || n > 10 {
+		t.Errorf("got %d IBANs expected between 1 and 10\n", n)
+	}
+	if c := e.Cursor(); c != "" {
+		t.Errorf("got cursor %q expected an empty cursor\n", c)
+	}
+}
+
+func TestEnumeratorSparse(t *testing.T) {
+	// Method 63 requires the first digit to be 0, so no account number above 0999999999 passes.
+	e, _ := NewEnumerator(CountryCodeDE, "10070000", "63")
+	if err := e.Seek("0999999990"); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if i, ok := e.Next(); ok {
+		t.Errorf("got %s expected no IBAN\n", i.String())
+	}
+	if e.Done() {
+		t.Errorf("expected the Enumerator not to be done\n")
+	}
+	if c, exp := e.Cursor(), "1000099990"; c != exp {
+		t.Errorf("got cursor %q expected=%q\n", c, exp)
+	}
+}
@@ -0,0 +1,90 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Format is a representation of an IBAN.
+type Format string
+
+const (
+	// FormatElectronic is the compact form without spaces, e.g. "DE89370400440532013000".
+	FormatElectronic Format = "electronic"
+	// FormatPrint is the paper form in groups of four, e.g. "DE89 3704 0044 0532 0130 00".
+	FormatPrint Format = "print"
+	// FormatMasked is the print form with all but the country code, the check digits
+	// and the last four characters masked, e.g. "DE89 **** **** **** **30 00".
+	FormatMasked Format = "masked"
+	// FormatURI is the URI form, e.g. "iban:DE89370400440532013000".
+	FormatURI Format = "uri"
+)
+
+// ErrUnknownFormat is returned for unknown formats.
+var ErrUnknownFormat = errors.New("unknown format")
+
+// maskChar replaces masked characters.
+const maskChar = '*'
+
+// Formats returns all formats.
+func Formats() []Format {
+	return []Format{FormatElectronic, FormatPrint, FormatMasked, FormatURI}
+}
+
+// Print returns the IBAN in the paper format in groups of four.
+func (i *IBAN) Print() string {
+	return group(i.String())
+}
+
+// Masked returns the IBAN in the print format with all but the country code,
+// the check digits and the last four characters masked.
+func (i *IBAN) Masked() string {
+	return group(mask(i.String()))
+}
+
+// URI returns the IBAN as an iban URI.
+func (i *IBAN) URI() string {
+	return "iban:" + i.String()
+}
+
+// Format returns the IBAN in the format.
+func (i *IBAN) Format(f Format) (string, error) {
+	return FormatString(i.String(), f)
+}
+
+// FormatString returns an IBAN in its electronic format in the format f.
+// The IBAN is not validated, so that invalid IBANs can be formatted as well.
+func FormatString(s string, f Format) (string, error) {
+	switch f {
+	case FormatElectronic:
+		return s, nil
+	case FormatPrint:
+		return group(s), nil
+	case FormatMasked:
+		return group(mask(s)), nil
+	case FormatURI:
+		return "iban:" + s, nil
+	}
+	return "", fmt.Errorf("%w %q", ErrUnknownFormat, string(f))
+}
+
+// group splits s into groups of four separated by spaces.
+func group(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if i > 0 && i%4 == 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// mask masks all but the first and last four characters of s.
+func mask(s string) string {
+	if len(s) <= 8 {
+		return s
+	}
+	return s[:4] + strings.Repeat(string(maskChar), len(s)-8) + s[len(s)-4:]
+}
@@ -0,0 +1,44 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	i, err := Parse("DE89370400440532013000")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	for _, tc := range []struct {
+		f   Format
+		exp string
+		err error
+	}{
+		{f: FormatElectronic, exp: "DE89370400440532013000"},
+		{f: FormatPrint, exp: "DE89 3704 0044 0532 0130 00"},
+		{f: FormatMasked, exp: "DE89 **** **** **** **30 00"},
+		{f: FormatURI, exp: "iban:DE89370400440532013000"},
+		{f: "pdf", err: ErrUnknownFormat},
+	} {
+		got, err := i.Format(tc.f)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: got err=%v expected=%v\n", tc.f, err, tc.err)
+		}
+		if got != tc.exp {
+			t.Errorf("%s: got %q expected=%q\n", tc.f, got, tc.exp)
+		}
+	}
+	if got := i.Print(); got != "DE89 3704 0044 0532 0130 00" {
+		t.Errorf("got %q\n", got)
+	}
+	if got := i.Masked(); got != "DE89 **** **** **** **30 00" {
+		t.Errorf("got %q\n", got)
+	}
+	if got := i.URI(); got != "iban:DE89370400440532013000" {
+		t.Errorf("got %q\n", got)
+	}
+	if got, _ := FormatString("DE8937", FormatMasked); got != "DE89 37" {
+		t.Errorf("got %q\n", got)
+	}
+}
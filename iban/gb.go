@@ -0,0 +1,53 @@
+package iban
+
+import (
+	"fmt"
+
+	"github.com/leonnicolas/iban-gen/sortcode"
+)
+
+var sortCodes = sortcode.NewTable()
+
+// SetSortCodeTable sets the table that is used to check the account numbers of GB IBANs.
+// Without a table GB account numbers are not checked.
+// It must not be called concurrently with other functions of this package.
+func SetSortCodeTable(t *sortcode.Table) {
+	sortCodes = t
+}
+
+// SortCodeTable returns the table that is used to check the account numbers of GB IBANs.
+func SortCodeTable() *sortcode.Table {
+	return sortCodes
+}
+
+// checkGB checks the account number with the modulus checking rules of its sort code.
+func checkGB(bban string) (string, bool) {
+	return bban, sortCodes.Check(bban[4:10], bban[10:]) == nil
+}
+
+// randomBankCodeGB returns a random bank code whose sort code has modulus
+// checking rules if the sort code table is not empty.
+func (g *Generator) randomBankCodeGB() string {
+	bc := g.randomString(countries["GB"].bankPattern())
+	rules := sortCodes.Rules()
+	if len(rules) == 0 {
+		return bc
+	}
+	ru := rules[g.intn(len(rules))]
+	return fmt.Sprintf("%s%06d", bc[:4], ru.From+g.intn(ru.To-ru.From+1))
+}
+
+// invalidateGB returns the GB IBAN with an account number that fails the modulus check of its sort code.
+func (g *Generator) invalidateGB(i *IBAN) (string, error) {
+	if len(sortCodes.Rules()) == 0 {
+		return "", fmt.Errorf("%w: GB account numbers are only checked with a sort code table", ErrUnsupportedDefect)
+	}
+	b := countries["GB"]
+	for n := 0; n < maxAttempts; n++ {
+		bban := b.join(i.bc, g.randomString(b.accountPattern()))
+		if _, ok := checkGB(bban); !ok {
+			return withCheckDigits(i.cc, bban), nil
+		}
+	}
+	return "", fmt.Errorf("%w: sort code %s is not checked by the sort code table", ErrUnsupportedDefect, i.bc[4:])
+}
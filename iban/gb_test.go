@@ -0,0 +1,56 @@
+package iban
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/leonnicolas/iban-gen/sortcode"
+)
+
+func TestGB(t *testing.T) {
+	tb := sortcode.NewTable()
+	if _, err := tb.Populate(strings.NewReader("089000 089999 MOD10    0    0    0    0    0    0    7    1    3    7    1    3    7    1\n")); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	SetSortCodeTable(tb)
+	defer SetSortCodeTable(sortcode.NewTable())
+
+	for n := 0; n < 10; n++ {
+		i, err := GenerateForCountry("GB")
+		if err != nil {
+			t.Fatalf("got err=%q\n", err.Error())
+		}
+		if sc := i.BankCode()[4:]; sc < "089000" || sc > "089999" {
+			t.Errorf("got sort code %s outside of the table\n", sc)
+		}
+		if err := tb.Check(i.BankCode()[4:], i.AccountNo()); err != nil {
+			t.Errorf("%s: got err=%q\n", i.String(), err.Error())
+		}
+		if _, err := Parse(i.String()); err != nil {
+			t.Errorf("%s: got err=%q\n", i.String(), err.Error())
+		}
+	}
+	bc, aNo := countries["GB"].split("WEST08999966374959")
+	i, err := IBAN{bc: bc, aNo: aNo, cc: "GB"}.check()
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if _, err := Parse(i.String()); !errors.Is(err, ErrNationalCheckDigit) {
+		t.Errorf("%s: got err=%v expected=%v\n", i.String(), err, ErrNationalCheckDigit)
+	}
+
+	s, err := NewGenerator(rand.NewSource(1)).Invalidate(i, DefectNationalCheckDigit)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if _, err := Parse(s); !errors.Is(err, ErrNationalCheckDigit) {
+		t.Errorf("%s: got err=%v expected=%v\n", s, err, ErrNationalCheckDigit)
+	}
+	// Sort codes without rules cannot be broken.
+	u, _ := GenerateFromBankCode("GB", "WEST123456")
+	if _, err := Invalidate(u, DefectNationalCheckDigit); !errors.Is(err, ErrUnsupportedDefect) {
+		t.Errorf("%s: got err=%v expected=%v\n", u.String(), err, ErrUnsupportedDefect)
+	}
+}
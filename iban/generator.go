@@ -0,0 +1,116 @@
+package iban
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Generator generates IBANs from a source of randomness.
+// It is safe for concurrent use.
+type Generator struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+// NewGenerator returns a new Generator that uses the given source.
+// Generators with sources that are seeded equally generate the same IBANs
+// as long as they are not used concurrently.
+func NewGenerator(src rand.Source) *Generator {
+	return &Generator{
+		r: rand.New(src),
+	}
+}
+
+// defaultGenerator is used by the package level functions.
+var defaultGenerator = NewGenerator(rand.NewSource(time.Now().UnixNano()))
+
+// GenerateForCountry generates an IBAN for a random BankCode for the given Country
+// with the default Generator.
+func GenerateForCountry(cc CountryCode) (*IBAN, error) {
+	return defaultGenerator.GenerateForCountry(cc)
+}
+
+// GenerateFromBankCode generates an IBAN for the given bank and country code
+// with the default Generator.
+func GenerateFromBankCode(cc CountryCode, bc string) (*IBAN, error) {
+	return defaultGenerator.GenerateFromBankCode(cc, bc)
+}
+
+// GenerateFromBankCodeAndMethod generates an IBAN for the given bank and country code
+// with an account number that passes the check method of the bank with the default Generator.
+func GenerateFromBankCodeAndMethod(cc CountryCode, bc, method string) (*IBAN, error) {
+	return defaultGenerator.GenerateFromBankCodeAndMethod(cc, bc, method)
+}
+
+// GenerateFromTemplate generates an IBAN that matches the template with the default Generator.
+func GenerateFromTemplate(tpl, method string) (*IBAN, error) {
+	return defaultGenerator.GenerateFromTemplate(tpl, method)
+}
+
+// GenerateSwiss generates an IBAN for Switzerland or Liechtenstein with the default Generator.
+func GenerateSwiss(cc CountryCode, qr bool) (*IBAN, error) {
+	return defaultGenerator.GenerateSwiss(cc, qr)
+}
+
+// GenerateQRReference generates a random QR reference with the default Generator.
+func GenerateQRReference() string {
+	return defaultGenerator.GenerateQRReference()
+}
+
+// GenerateBatch generates n distinct IBANs for the bank code and check method with the default Generator.
+func GenerateBatch(n int, cc CountryCode, bc, method string) ([]*IBAN, error) {
+	return defaultGenerator.GenerateBatch(n, cc, bc, method)
+}
+
+// Invalidate returns the IBAN with the defect applied with the default Generator.
+func Invalidate(i *IBAN, d Defect) (string, error) {
+	return defaultGenerator.Invalidate(i, d)
+}
+
+// InvalidateBankCode returns the IBAN with an unknown bank code with the default Generator.
+func InvalidateBankCode(i *IBAN, known func(bc string) bool) (string, error) {
+	return defaultGenerator.InvalidateBankCode(i, known)
+}
+
+// InvalidateAccountNo returns the German IBAN with an account number that fails the check method
+// with the default Generator.
+func InvalidateAccountNo(i *IBAN, method string) (string, error) {
+	return defaultGenerator.InvalidateAccountNo(i, method)
+}
+
+func (g *Generator) intn(n int) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.r.Intn(n)
+}
+
+const (
+	digits   = "0123456789"
+	letters  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	alphaNum = digits + letters
+)
+
+// randomString returns a random string matching the character classes of the pattern.
+func (g *Generator) randomString(p string) string {
+	ret := make([]byte, len(p))
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for i := range ret {
+		chars := classChars(p[i])
+		ret[i] = chars[g.r.Intn(len(chars))]
+	}
+	return string(ret)
+}
+
+// classChars returns all characters of a character class.
+func classChars(class byte) string {
+	switch class {
+	case classLetter:
+		return letters
+	case classAlphaNum:
+		return alphaNum
+	default:
+		return digits
+	}
+}
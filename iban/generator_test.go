@@ -0,0 +1,48 @@
+package iban
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestGeneratorSeed(t *testing.T) {
+	for _, cc := range CountryCodes() {
+		g1 := NewGenerator(rand.NewSource(42))
+		g2 := NewGenerator(rand.NewSource(42))
+		for n := 0; n < 10; n++ {
+			i1, err := g1.GenerateForCountry(cc)
+			if err != nil {
+				t.Fatalf("%s: got err=%q\n", cc, err.Error())
+			}
+			i2, err := g2.GenerateForCountry(cc)
+			if err != nil {
+				t.Fatalf("%s: got err=%q\n", cc, err.Error())
+			}
+			if i1.String() != i2.String() {
+				t.Errorf("%s: got %s and %s for the same seed\n", cc, i1.String(), i2.String())
+			}
+		}
+	}
+}
+
+func TestGeneratorConcurrent(t *testing.T) {
+	g := NewGenerator(rand.NewSource(1))
+	var wg sync.WaitGroup
+	for n := 0; n < 8; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if _, err := g.GenerateFromBankCodeAndMethod(CountryCodeDE, "37040044", "00"); err != nil {
+					t.Errorf("got err=%q\n", err.Error())
+				}
+				if _, err := GenerateForCountry("GB"); err != nil {
+					t.Errorf("got err=%q\n", err.Error())
+				}
+				g.GenerateQRReference()
+			}
+		}()
+	}
+	wg.Wait()
+}
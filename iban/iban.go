@@ -3,9 +3,9 @@ package iban
 import (
 	"fmt"
 	"math/big"
-	"math/rand"
 	"regexp"
-	"time"
+	"strconv"
+	"strings"
 )
 
 // CountryCode is the country code of a Bank.
@@ -16,8 +16,6 @@ const (
 	CountryCodeDE = "DE"
 )
 
-var random = rand.New(rand.NewSource(time.Now().Unix()))
-
 // IBAN represents an IBAN.
 type IBAN struct {
 	bc  string
@@ -28,18 +26,66 @@ type IBAN struct {
 }
 
 // GenerateForCountry generates an IBAN for a random BankCode for the given Country.
-func GenerateForCountry(cc CountryCode) (*IBAN, error) {
-	return GenerateFromBankCode(cc, randomNoString(8))
+func (g *Generator) GenerateForCountry(cc CountryCode) (*IBAN, error) {
+	b, ok := countries[cc]
+	if !ok {
+		return nil, fmt.Errorf("country code %q is not supported", string(cc))
+	}
+	switch cc {
+	case "GB":
+		return g.GenerateFromBankCode(cc, g.randomBankCodeGB())
+	case CountryCodeCH, CountryCodeLI:
+		return g.GenerateSwiss(cc, false)
+	}
+	return g.GenerateFromBankCode(cc, g.randomString(b.bankPattern()))
 }
 
 // GenerateFromBankCode generates an IBAN for the given bank and country code.
-func GenerateFromBankCode(cc CountryCode, bc string) (*IBAN, error) {
-	if cc == CountryCodeDE && len(bc) != 8 {
-		return nil, fmt.Errorf("bank code must be %d charackters for %s", 8, string(cc))
+// Use GenerateFromTemplate to constrain the account number, too.
+func (g *Generator) GenerateFromBankCode(cc CountryCode, bc string) (*IBAN, error) {
+	b, ok := countries[cc]
+	if !ok {
+		return nil, fmt.Errorf("country code %q is not supported", string(cc))
+	}
+	if l := b.bankCode[1] - b.bankCode[0]; len(bc) != l {
+		return nil, fmt.Errorf("bank code must be %d characters for %s", l, string(cc))
+	}
+	if !matches(b.bankPattern(), bc) {
+		return nil, fmt.Errorf("bank code %q does not match the BBAN structure %s of %s", bc, b.format, string(cc))
+	}
+	for n := 0; n < maxAttempts; n++ {
+		bban, ok := withNationalCheckDigits(cc, b.join(bc, g.randomString(b.accountPattern())))
+		if !ok {
+			continue
+		}
+		_, aNo := b.split(bban)
+		return IBAN{
+			bc:  bc,
+			aNo: aNo,
+			cc:  cc,
+		}.check()
+	}
+	return nil, fmt.Errorf("failed to find an account number with national check digits for %s", string(cc))
+}
+
+// GenerateFromBankCodeAndMethod generates an IBAN for the given bank and country code
+// with an account number that passes the check method of the bank.
+// Check methods are only used for Germany. An empty method generates any account number
+// and ErrUnsupportedMethod is returned for methods that are not implemented.
+func (g *Generator) GenerateFromBankCodeAndMethod(cc CountryCode, bc, method string) (*IBAN, error) {
+	if cc != CountryCodeDE {
+		return g.GenerateFromBankCode(cc, bc)
+	}
+	if len(bc) != 8 || !matches(countries[cc].bankPattern(), bc) {
+		return nil, fmt.Errorf("bank code must be %d digits for %s", 8, string(cc))
+	}
+	aNo, err := g.randomAccountNo(method, bc)
+	if err != nil {
+		return nil, err
 	}
 	return IBAN{
 		bc:  bc,
-		aNo: randomNoString(10),
+		aNo: aNo,
 		cc:  cc,
 	}.check()
 }
@@ -59,25 +105,31 @@ func (i *IBAN) AccountNo() string {
 	return i.aNo
 }
 
+// CheckDigits returns the two check digits.
+func (i *IBAN) CheckDigits() string {
+	return i.cs
+}
+
 // CountryCode returns the CountryCode.
 func (i *IBAN) CountryCode() string {
 	return string(i.cc)
 }
 
-// String returns the string representation of the IBAN.
-func (i *IBAN) String() string {
-	return fmt.Sprintf("%s%s%s%s", i.cc, i.cs, i.bc, i.aNo)
+// BBAN returns the Basic Bank Account Number of the IBAN.
+func (i *IBAN) BBAN() string {
+	if b, ok := countries[i.cc]; ok {
+		return b.join(i.bc, i.aNo)
+	}
+	return i.bc + i.aNo
 }
 
-func randomNoString(l uint) (s string) {
-	for i := uint(0); i < l; i++ {
-		s = fmt.Sprintf("%s%d", s, random.Intn(10))
-	}
-	return
+// String returns the string representation of the IBAN.
+func (i *IBAN) String() string {
+	return fmt.Sprintf("%s%s%s", i.cc, i.cs, i.BBAN())
 }
 
 func (i IBAN) check() (*IBAN, error) {
-	b, ok := big.NewInt(0).SetString(fmt.Sprintf("%s%s%s00", i.bc, i.aNo, i.cc.toNum()), 10)
+	b, ok := big.NewInt(0).SetString(toNum(i.BBAN())+i.cc.toNum()+"00", 10)
 	if !ok {
 		return nil, fmt.Errorf("failed to convert bank account number %q to big int", i.bc)
 	}
@@ -88,5 +140,18 @@ func (i IBAN) check() (*IBAN, error) {
 var countryCode = regexp.MustCompile(`^[A-Z]{2}$`)
 
 func (c CountryCode) toNum() string {
-	return "1314"
+	return toNum(string(c))
+}
+
+// toNum replaces every letter of s with two digits, A = 10, B = 11, ..., Z = 35.
+func toNum(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r >= 'A' && r <= 'Z' {
+			sb.WriteString(strconv.Itoa(int(r-'A') + 10))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
 }
@@ -0,0 +1,151 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Defect is a deliberate defect of an invalid IBAN.
+type Defect string
+
+const (
+	// DefectCheckDigits replaces the check digits with wrong ones.
+	DefectCheckDigits Defect = "checkDigits"
+	// DefectLength adds or removes a character.
+	DefectLength Defect = "length"
+	// DefectCountry replaces the country code with one that is not in the IBAN registry.
+	DefectCountry Defect = "country"
+	// DefectLetters replaces a digit of the BBAN with a letter.
+	DefectLetters Defect = "letters"
+	// DefectBankCode replaces the bank code with an unknown one.
+	DefectBankCode Defect = "bankCode"
+	// DefectNationalCheckDigit makes the national check digits or, for German and GB IBANs,
+	// the account number check wrong.
+	DefectNationalCheckDigit Defect = "nationalCheckDigit"
+)
+
+// ErrUnsupportedDefect is returned if a defect cannot be applied to an IBAN.
+var ErrUnsupportedDefect = errors.New("unsupported defect")
+
+// Defects returns all defects.
+func Defects() []Defect {
+	return []Defect{
+		DefectCheckDigits,
+		DefectLength,
+		DefectCountry,
+		DefectLetters,
+		DefectBankCode,
+		DefectNationalCheckDigit,
+	}
+}
+
+// Invalidate returns the IBAN with the defect applied. Apart from the defect the IBAN stays valid,
+// e.g. the check digits are recomputed if the BBAN changes.
+// DefectBankCode and German account numbers are handled by InvalidateBankCode and InvalidateAccountNo,
+// because they depend on bank data.
+func (g *Generator) Invalidate(i *IBAN, d Defect) (string, error) {
+	b := countries[i.cc]
+	bban := i.BBAN()
+	switch d {
+	case DefectCheckDigits:
+		// The check digits 2 to 98 are all distinct modulo 97, so only the original ones are valid.
+		cs := i.cs
+		for cs == i.cs {
+			cs = fmt.Sprintf("%02d", 2+g.intn(97))
+		}
+		return string(i.cc) + cs + bban, nil
+	case DefectLength:
+		if g.intn(2) == 0 {
+			return i.String()[:b.length-1], nil
+		}
+		return i.String() + g.randomString(b.pattern[len(b.pattern)-1:]), nil
+	case DefectCountry:
+		for {
+			cc := CountryCode(g.randomString("aa"))
+			if _, ok := countries[cc]; !ok {
+				return withCheckDigits(cc, bban), nil
+			}
+		}
+	case DefectLetters:
+		var pos []int
+		for j := 0; j < len(b.pattern); j++ {
+			if b.pattern[j] == classDigit {
+				pos = append(pos, j)
+			}
+		}
+		if len(pos) == 0 {
+			return "", fmt.Errorf("%w: the BBAN of %s has no digits", ErrUnsupportedDefect, i.cc)
+		}
+		j := pos[g.intn(len(pos))]
+		return withCheckDigits(i.cc, bban[:j]+g.randomString(string(classLetter))+bban[j+1:]), nil
+	case DefectNationalCheckDigit:
+		if i.cc == "GB" {
+			return g.invalidateGB(i)
+		}
+		check, ok := nationalCheckDigits[i.cc]
+		if !ok {
+			return "", fmt.Errorf("%w: %s has no national check digits", ErrUnsupportedDefect, i.cc)
+		}
+		j := check[0] + g.intn(check[1]-check[0])
+		p := b.pattern[j : j+1]
+		c := bban[j : j+1]
+		for c == bban[j:j+1] {
+			c = g.randomString(p)
+		}
+		return withCheckDigits(i.cc, bban[:j]+c+bban[j+1:]), nil
+	case DefectBankCode:
+		return "", fmt.Errorf("%w: %s needs bank data, use InvalidateBankCode", ErrUnsupportedDefect, d)
+	}
+	return "", fmt.Errorf("%w %q", ErrUnsupportedDefect, d)
+}
+
+// DefectiveBankCode returns the characters of the invalid IBAN s at the positions of the bank code
+// of the valid IBAN i, which a defect was applied to. It is shorter than the bank code if s is.
+func DefectiveBankCode(i *IBAN, s string) string {
+	b := countries[i.cc]
+	from, to := 4+b.bankCode[0], 4+b.bankCode[1]
+	if to > len(s) {
+		to = len(s)
+	}
+	if from > to {
+		return ""
+	}
+	return s[from:to]
+}
+
+// InvalidateBankCode returns the IBAN with a random bank code for which known returns false.
+func (g *Generator) InvalidateBankCode(i *IBAN, known func(bc string) bool) (string, error) {
+	b := countries[i.cc]
+	for n := 0; n < maxAttempts; n++ {
+		bc := g.randomString(b.bankPattern())
+		if bc == i.bc || known(bc) {
+			continue
+		}
+		if bban, ok := withNationalCheckDigits(i.cc, b.join(bc, i.aNo)); ok {
+			return withCheckDigits(i.cc, bban), nil
+		}
+	}
+	return "", fmt.Errorf("failed to find an unknown bank code for %s", i.cc)
+}
+
+// InvalidateAccountNo returns the German IBAN with an account number that fails the check method.
+func (g *Generator) InvalidateAccountNo(i *IBAN, method string) (string, error) {
+	if i.cc != CountryCodeDE {
+		return g.Invalidate(i, DefectNationalCheckDigit)
+	}
+	if _, err := checked(method); err != nil {
+		return "", err
+	}
+	p := countries[CountryCodeDE].accountPattern()
+	for n := 0; n < maxAttempts; n++ {
+		if aNo := g.randomString(p); CheckBankAccountNo(method, i.bc, aNo) != nil {
+			return withCheckDigits(i.cc, i.bc+aNo), nil
+		}
+	}
+	return "", fmt.Errorf("%w: every account number passes check method %s", ErrUnsupportedDefect, method)
+}
+
+// withCheckDigits returns the IBAN of the country and BBAN with valid check digits.
+func withCheckDigits(cc CountryCode, bban string) string {
+	return fmt.Sprintf("%s%02d%s", cc, 98-mod97(toNum(bban+string(cc))+"00"), bban)
+}
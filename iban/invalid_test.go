@@ -0,0 +1,102 @@
+package iban
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestInvalidate(t *testing.T) {
+	g := NewGenerator(rand.NewSource(1))
+	for _, tc := range []struct {
+		d   Defect
+		err error
+	}{
+		{d: DefectCheckDigits, err: ErrInvalidChecksum},
+		{d: DefectLength, err: ErrInvalidLength},
+		{d: DefectCountry, err: ErrUnknownCountry},
+		{d: DefectLetters, err: ErrInvalidBBAN},
+		{d: DefectNationalCheckDigit, err: ErrNationalCheckDigit},
+	} {
+		for _, cc := range CountryCodes() {
+			for n := 0; n < 10; n++ {
+				i, err := g.GenerateForCountry(cc)
+				if err != nil {
+					t.Fatalf("%s: got err=%q\n", cc, err.Error())
+				}
+				s, err := g.Invalidate(i, tc.d)
+				if errors.Is(err, ErrUnsupportedDefect) {
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s %s: got err=%q\n", i.String(), tc.d, err.Error())
+				}
+				if _, err := Parse(s); !errors.Is(err, tc.err) {
+					t.Errorf("%s %s: got %s with err=%v expected=%v\n", i.String(), tc.d, s, err, tc.err)
+				}
+			}
+		}
+	}
+	i, _ := g.GenerateForCountry("LC")
+	if _, err := g.Invalidate(i, DefectLetters); !errors.Is(err, ErrUnsupportedDefect) {
+		t.Errorf("%s: got err=%v expected=%v\n", i.String(), err, ErrUnsupportedDefect)
+	}
+}
+
+func TestInvalidateBankCode(t *testing.T) {
+	i, err := GenerateFromBankCode(CountryCodeDE, "37040044")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	known := func(bc string) bool { return bc[0] != '9' }
+	s, err := InvalidateBankCode(i, known)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	u, err := Parse(s)
+	if err != nil {
+		t.Fatalf("%s: got err=%q\n", s, err.Error())
+	}
+	if known(u.BankCode()) || u.AccountNo() != i.AccountNo() {
+		t.Errorf("%s: got bank code %s and account number %s\n", s, u.BankCode(), u.AccountNo())
+	}
+}
+
+func TestDefectiveBankCode(t *testing.T) {
+	i, _ := Parse("FR1420041010050500013M02606")
+	for _, tc := range []struct {
+		s  string
+		bc string
+	}{
+		{s: "FR1420041010050500013M02606", bc: "2004101005"},
+		{s: "FR84H0041010050500013M02606", bc: "H004101005"},
+		{s: "XQ1420041010050500013M02606", bc: "2004101005"},
+		{s: "FR14200410100", bc: "200410100"},
+		{s: "FR14", bc: ""},
+	} {
+		if bc := DefectiveBankCode(i, tc.s); bc != tc.bc {
+			t.Errorf("%s: got %q expected=%q\n", tc.s, bc, tc.bc)
+		}
+	}
+}
+
+func TestInvalidateAccountNo(t *testing.T) {
+	i, err := GenerateFromBankCodeAndMethod(CountryCodeDE, "37040044", "13")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	s, err := InvalidateAccountNo(i, "13")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	u, err := Parse(s)
+	if err != nil {
+		t.Fatalf("%s: got err=%q\n", s, err.Error())
+	}
+	if err := CheckAccountNo("13", u.AccountNo()); !errors.Is(err, ErrAccountCheckDigit) {
+		t.Errorf("%s: got err=%v expected=%v\n", s, err, ErrAccountCheckDigit)
+	}
+	if _, err := InvalidateAccountNo(i, "09"); !errors.Is(err, ErrUnsupportedDefect) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrUnsupportedDefect)
+	}
+}
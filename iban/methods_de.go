@@ -0,0 +1,902 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+var (
+	// ErrUnsupportedMethod is returned for check methods that are not implemented.
+	ErrUnsupportedMethod = errors.New("unsupported check method")
+	// ErrMalformedAccountNo is returned for account numbers that are not up to ten digits.
+	ErrMalformedAccountNo = errors.New("account number must consist of up to ten digits")
+	// ErrAccountCheckDigit is returned for account numbers that fail their check method.
+	ErrAccountCheckDigit = errors.New("account number check digit is wrong")
+)
+
+// accountNo is a German account number with ten digits.
+// Positions are counted from 1 to 10 from the left like in the
+// check method specification of the Deutsche Bundesbank.
+type accountNo [10]int
+
+func (a accountNo) pos(i int) int {
+	return a[i-1]
+}
+
+// shift returns the account number shifted left by two digits,
+// which is how most methods treat a missing sub account number.
+func (a accountNo) shift() accountNo {
+	return a.shiftLeft(2)
+}
+
+// shiftLeft returns the account number shifted left by n digits.
+func (a accountNo) shiftLeft(n int) accountNo {
+	var ret accountNo
+	copy(ret[:], a[n:])
+	return ret
+}
+
+// prefix returns the number of the first n digits.
+func (a accountNo) prefix(n int) int {
+	p := 0
+	for _, d := range a[:n] {
+		p = p*10 + d
+	}
+	return p
+}
+
+func (a accountNo) between(lo, hi int) bool {
+	n := 0
+	for _, d := range a {
+		n = n*10 + d
+	}
+	return n >= lo && n <= hi
+}
+
+// sum multiplies the digits from position to down to position from
+// with the weights and sums up the products.
+func (a accountNo) sum(from, to int, weights []int, crossSum bool) int {
+	s := 0
+	for i, j := to, 0; i >= from; i, j = i-1, j+1 {
+		p := a.pos(i) * weights[j%len(weights)]
+		if crossSum {
+			p = p/10 + p%10
+		}
+		s += p
+	}
+	return s
+}
+
+// mod10 checks the digit at position check with modulus 10.
+func (a accountNo) mod10(from, to, check int, weights []int, crossSum bool) bool {
+	return (10-a.sum(from, to, weights, crossSum)%10)%10 == a.pos(check)
+}
+
+// mod10Prefix checks the digit at position 10 like method 00
+// with the digits of the prefix in front of the positions from to 9.
+func (a accountNo) mod10Prefix(prefix string, from int) bool {
+	s := a.sum(from, 9, w21, true)
+	for i, j := len(prefix)-1, 10-from; i >= 0; i, j = i-1, j+1 {
+		p := int(prefix[i]-'0') * w21[j%2]
+		s += p/10 + p%10
+	}
+	return (10-s%10)%10 == a.pos(10)
+}
+
+// mod7 checks the digit at position check with modulus 7.
+func (a accountNo) mod7(from, to, check int, weights []int, crossSum bool) bool {
+	return (7-a.sum(from, to, weights, crossSum)%7)%7 == a.pos(check)
+}
+
+// mod11 checks the digit at position check with modulus 11.
+// The check digit is 0 for remainders 0 and 1.
+func (a accountNo) mod11(from, to, check int, weights []int) bool {
+	r := a.sum(from, to, weights, false) % 11
+	if r <= 1 {
+		return a.pos(check) == 0
+	}
+	return 11-r == a.pos(check)
+}
+
+// mod11Strict checks the digit at position check with modulus 11.
+// Remainder 1 makes the account number invalid.
+func (a accountNo) mod11Strict(from, to, check int, weights []int) bool {
+	r := a.sum(from, to, weights, false) % 11
+	switch r {
+	case 0:
+		return a.pos(check) == 0
+	case 1:
+		return false
+	}
+	return 11-r == a.pos(check)
+}
+
+var (
+	w21      = []int{2, 1}
+	w12      = []int{1, 2}
+	w371     = []int{3, 7, 1}
+	w731     = []int{7, 3, 1}
+	w3971    = []int{3, 9, 7, 1}
+	w2to6    = []int{2, 3, 4, 5, 6}
+	w2to7    = []int{2, 3, 4, 5, 6, 7}
+	w2to8    = []int{2, 3, 4, 5, 6, 7, 8}
+	w2to9    = []int{2, 3, 4, 5, 6, 7, 8, 9}
+	w2to10   = []int{2, 3, 4, 5, 6, 7, 8, 9, 10}
+	w1to9    = []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	w248     = []int{2, 4, 8, 5, 10, 9, 7}
+	w2to9And = []int{2, 3, 4, 5, 6, 7, 8, 9, 3}
+)
+
+// checkMethods contains the implemented check methods of the Deutsche Bundesbank
+// that only depend on the account number.
+var checkMethods = map[string]func(a accountNo) bool{
+	"00": method00,
+	"01": method01,
+	"02": method02,
+	"03": method03,
+	"04": method04,
+	"05": method05,
+	"06": method06,
+	"07": method07,
+	"08": func(a accountNo) bool { return a.between(0, 59999) || method00(a) },
+	"09": func(a accountNo) bool { return true },
+	"10": method10,
+	"11": func(a accountNo) bool {
+		r := a.sum(1, 9, w2to10, false) % 11
+		switch r {
+		case 0:
+			return a.pos(10) == 0
+		case 1:
+			return a.pos(10) == 9
+		}
+		return 11-r == a.pos(10)
+	},
+	"13": func(a accountNo) bool {
+		return a.mod10(2, 7, 8, w21, true) || a.shift().mod10(2, 7, 8, w21, true)
+	},
+	"16": func(a accountNo) bool {
+		if a.sum(1, 9, w2to7, false)%11 == 1 {
+			return a.pos(9) == a.pos(10)
+		}
+		return method06(a)
+	},
+	"17": method17,
+	"18": method18,
+	"19": method19,
+	"20": method20,
+	"21": method21,
+	"22": method22,
+	"23": func(a accountNo) bool {
+		r := a.sum(1, 6, w2to7, false) % 11
+		switch r {
+		case 0:
+			return a.pos(7) == 0
+		case 1:
+			return a.pos(7) == a.pos(6)
+		}
+		return 11-r == a.pos(7)
+	},
+	"24": method24,
+	"25": func(a accountNo) bool {
+		r := a.sum(2, 9, w2to9, false) % 11
+		switch r {
+		case 0:
+			return a.pos(10) == 0
+		case 1:
+			// Only account numbers with an 8 or 9 at position 2 can have remainder 1.
+			return a.pos(10) == 0 && (a.pos(2) == 8 || a.pos(2) == 9)
+		}
+		return 11-r == a.pos(10)
+	},
+	"26": func(a accountNo) bool {
+		if a.pos(1) == 0 && a.pos(2) == 0 {
+			a = a.shift()
+		}
+		return a.mod11(1, 7, 8, []int{2, 3, 4, 5, 6, 7, 2})
+	},
+	"27": func(a accountNo) bool {
+		if a.pos(1) == 0 {
+			return method00(a)
+		}
+		return method29(a)
+	},
+	"28": func(a accountNo) bool { return a.mod11(1, 7, 8, w2to8) },
+	"29": method29,
+	"30": func(a accountNo) bool { return a.mod10(1, 9, 10, []int{2, 1, 2, 1, 0, 0, 0, 0, 2}, false) },
+	"31": func(a accountNo) bool {
+		r := a.sum(1, 9, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}, false) % 11
+		return r != 10 && r == a.pos(10)
+	},
+	"32": method32,
+	"33": func(a accountNo) bool { return a.mod11(5, 9, 10, w2to6) },
+	"34": func(a accountNo) bool { return a.mod11(1, 7, 8, w248) },
+	"38": func(a accountNo) bool { return a.mod11(4, 9, 10, w248) },
+	"40": method10,
+	"41": func(a accountNo) bool {
+		// A 9 at position 4 marks account numbers without the first three digits.
+		if a.pos(4) == 9 {
+			return a.mod10(4, 9, 10, w21, true)
+		}
+		return method00(a)
+	},
+	"42": func(a accountNo) bool { return a.mod11(2, 9, 10, w2to9) },
+	"43": func(a accountNo) bool { return (10-a.sum(1, 9, w1to9, false)%10)%10 == a.pos(10) },
+	"44": func(a accountNo) bool { return a.mod11(5, 9, 10, []int{2, 4, 8, 5, 10}) },
+	"46": func(a accountNo) bool { return a.mod11(3, 7, 8, w2to6) },
+	"47": func(a accountNo) bool { return a.mod11(4, 8, 9, w2to6) },
+	"48": func(a accountNo) bool { return a.mod11(3, 8, 9, w2to7) },
+	"49": func(a accountNo) bool { return method00(a) || method01(a) },
+	"50": func(a accountNo) bool {
+		if a.mod11Strict(1, 6, 7, w2to7) {
+			return true
+		}
+		// The sub account number 000 can be missing.
+		return a.pos(1) == 0 && a.pos(2) == 0 && a.pos(3) == 0 && a.shiftLeft(3).mod11Strict(1, 6, 7, w2to7)
+	},
+	"51": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return ledger(a)
+		}
+		return a.mod11(4, 9, 10, w2to7) ||
+			a.mod11(5, 9, 10, w2to6) ||
+			a.mod10(4, 9, 10, w21, true) ||
+			(a.pos(10) < 7 && a.mod7(5, 9, 10, w2to6, false))
+	},
+	"55": func(a accountNo) bool { return a.mod11(1, 9, 10, []int{2, 3, 4, 5, 6, 7, 8, 7, 8}) },
+	"56": func(a accountNo) bool {
+		c := 11 - a.sum(1, 9, []int{2, 3, 4, 5, 6, 7, 2, 3, 4}, false)%11
+		if a.pos(1) == 9 {
+			switch c {
+			case 10:
+				c = 7
+			case 11:
+				c = 8
+			}
+		}
+		return c == a.pos(10)
+	},
+	"57": method57,
+	"59": func(a accountNo) bool { return a.between(0, 99999999) || method00(a) },
+	"60": func(a accountNo) bool { return a.mod10(3, 9, 10, w21, true) },
+	"61": func(a accountNo) bool { return method61(a, 8) },
+	"63": method63,
+	"64": func(a accountNo) bool { return a.mod11(1, 6, 7, w248) },
+	"65": func(a accountNo) bool { return method61(a, 9) },
+	"66": func(a accountNo) bool {
+		if a.pos(2) == 9 {
+			return true
+		}
+		if a.pos(1) != 0 {
+			return false
+		}
+		r := a.sum(2, 9, []int{2, 3, 4, 5, 6, 0, 0, 7}, false) % 11
+		switch r {
+		case 0:
+			return a.pos(10) == 1
+		case 1:
+			return a.pos(10) == 0
+		}
+		return 11-r == a.pos(10)
+	},
+	"67": func(a accountNo) bool { return a.mod10(1, 7, 8, w21, true) },
+	"68": method68,
+	"71": func(a accountNo) bool {
+		r := a.sum(2, 7, []int{1, 2, 3, 4, 5, 6}, false) % 11
+		if r <= 1 {
+			return r == a.pos(10)
+		}
+		return 11-r == a.pos(10)
+	},
+	"73": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return ledger(a)
+		}
+		return a.mod10(4, 9, 10, w21, true) || a.mod10(5, 9, 10, w21, true) || a.mod7(5, 9, 10, w21, true)
+	},
+	"74": func(a accountNo) bool {
+		if a.between(0, 9) {
+			return false
+		}
+		if method00(a) {
+			return true
+		}
+		// Six digit account numbers can also have the difference to the next half decade as check digit.
+		return a.between(100000, 999999) && (15-a.sum(1, 9, w21, true)%10)%10 == a.pos(10)
+	},
+	"76": func(a accountNo) bool { return method76(a) || method76(a.shift()) },
+	"78": func(a accountNo) bool { return a.between(10000000, 99999999) || method00(a) },
+	"81": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return ledger(a)
+		}
+		return method32(a)
+	},
+	"84": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return ledger(a)
+		}
+		return a.mod11(5, 9, 10, w2to6) || a.mod7(5, 9, 10, w2to6, false) || a.mod10(5, 9, 10, w21, false)
+	},
+	"85": func(a accountNo) bool {
+		if a.pos(3) == 9 && a.pos(4) == 9 {
+			return a.mod11(3, 9, 10, w2to8)
+		}
+		return a.mod11(4, 9, 10, w2to7) ||
+			a.mod11(5, 9, 10, w2to6) ||
+			(a.pos(10) < 7 && a.mod7(5, 9, 10, w2to6, false))
+	},
+	"87": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return ledger(a)
+		}
+		return method87(a) ||
+			a.mod11(5, 9, 10, w2to6) ||
+			(a.pos(10) < 7 && a.mod7(5, 9, 10, w2to6, false))
+	},
+	"88": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return a.mod11(3, 9, 10, w2to8)
+		}
+		return a.mod11(4, 9, 10, w2to7)
+	},
+	"91": func(a accountNo) bool {
+		return a.mod11(1, 6, 7, w2to7) ||
+			a.mod11(1, 6, 7, []int{7, 6, 5, 4, 3, 2}) ||
+			a.mod11(1, 10, 7, []int{2, 3, 4, 0, 5, 6, 7, 8, 9, 10}) ||
+			a.mod11(1, 6, 7, w248)
+	},
+	"92": func(a accountNo) bool { return a.mod10(4, 9, 10, w371, false) },
+	"94": func(a accountNo) bool { return a.mod10(1, 9, 10, w12, true) },
+	"95": method95,
+	"96": func(a accountNo) bool { return method19(a) || method00(a) || a.between(1300000, 99399999) },
+	"98": func(a accountNo) bool { return a.mod10(3, 9, 10, []int{3, 1, 7}, false) || method32(a) },
+	"99": func(a accountNo) bool { return a.between(396000000, 499999999) || method06(a) },
+	"A2": func(a accountNo) bool { return method00(a) || method04(a) },
+	"A3": func(a accountNo) bool { return method00(a) || method10(a) },
+	"A4": func(a accountNo) bool {
+		if a.pos(3) == 9 && a.pos(4) == 9 {
+			return a.mod11(5, 9, 10, w2to6) || a.mod7(5, 9, 10, w2to6, false)
+		}
+		return a.mod11(4, 9, 10, w2to7) || a.mod7(4, 9, 10, w2to7, false) || a.mod7(5, 9, 10, w2to6, false)
+	},
+	"A5": func(a accountNo) bool { return method00(a) || (a.pos(1) != 9 && method10(a)) },
+	"A6": func(a accountNo) bool {
+		if a.pos(2) == 8 {
+			return method00(a)
+		}
+		return method01(a)
+	},
+	"A7": func(a accountNo) bool { return method00(a) || method03(a) },
+	"A8": func(a accountNo) bool {
+		if a.pos(3) == 9 {
+			return ledger(a)
+		}
+		return method32(a) || a.mod10(4, 9, 10, w21, true)
+	},
+	"B1": func(a accountNo) bool { return method05(a) || method01(a) || method00(a) },
+	"B2": func(a accountNo) bool {
+		if a.pos(1) <= 7 {
+			return method02(a)
+		}
+		return method00(a)
+	},
+	"B3": func(a accountNo) bool {
+		if a.pos(1) <= 8 {
+			return method32(a)
+		}
+		return method06(a)
+	},
+	"B5": func(a accountNo) bool {
+		if method05(a) {
+			return true
+		}
+		return a.pos(1) != 8 && a.pos(1) != 9 && method00(a)
+	},
+	"B7": func(a accountNo) bool {
+		if a.between(1000000, 5999999) || a.between(700000000, 899999999) {
+			return a.mod10(1, 9, 10, w371, false)
+		}
+		return true
+	},
+	"B8": func(a accountNo) bool {
+		if method20(a) || method29(a) {
+			return true
+		}
+		// 5100000000 to 5999999999 and 9010000000 to 9109999999 are not checked.
+		return (a.pos(1) == 5 && a.pos(2) != 0) || (a.prefix(3) >= 901 && a.prefix(3) <= 910)
+	},
+	"C1": func(a accountNo) bool {
+		if a.pos(1) != 5 {
+			return method17(a)
+		}
+		r := (a.sum(1, 9, w12, true) - 1) % 11
+		if r == 0 {
+			return a.pos(10) == 0
+		}
+		return 10-r == a.pos(10)
+	},
+	"C2": func(a accountNo) bool { return method22(a) || method00(a) },
+	"C3": func(a accountNo) bool {
+		if a.pos(1) != 9 {
+			return method00(a)
+		}
+		return a.mod11Strict(5, 9, 10, w2to6)
+	},
+	"C5": func(a accountNo) bool {
+		switch {
+		case a.between(100000, 899999), a.between(100000000, 899999999):
+			return method75(a)
+		case a.pos(1) == 1, a.pos(1) == 4, a.pos(1) == 5, a.pos(1) == 6, a.pos(1) == 9:
+			return method29(a)
+		case a.pos(1) == 3:
+			return method00(a)
+		}
+		// These account numbers are not checked.
+		return a.between(30000000, 59999999) || a.prefix(2) == 70 || a.prefix(2) == 85
+	},
+	"C6": func(a accountNo) bool {
+		return a.mod10Prefix([]string{
+			"4451970", "4451981", "4451992", "4451993", "4344992",
+			"4344990", "4344991", "5499570", "4451994", "5499579",
+		}[a.pos(1)], 2)
+	},
+	"C7": func(a accountNo) bool { return method63(a) || method06(a) },
+	"C8": func(a accountNo) bool { return method00(a) || method04(a) || method07(a) },
+	"C9": func(a accountNo) bool { return method00(a) || method07(a) },
+	"D0": func(a accountNo) bool {
+		// Account numbers that start with 57 are not checked.
+		return a.prefix(2) == 57 || method20(a)
+	},
+	"D1": func(a accountNo) bool {
+		return a.pos(1) != 8 && a.mod10Prefix("436338", 1)
+	},
+	"D2": func(a accountNo) bool { return method95(a) || method00(a) || method68(a) },
+	"D4": func(a accountNo) bool { return a.pos(1) != 0 && a.mod10Prefix("428259", 1) },
+	"D6": func(a accountNo) bool { return method07(a) || method03(a) || method00(a) },
+	"D7": func(a accountNo) bool { return a.sum(1, 9, w21, true)%10 == a.pos(10) },
+	"D8": func(a accountNo) bool {
+		if a.pos(1) != 0 {
+			return method00(a)
+		}
+		// 0010000000 to 0099999999 are not checked.
+		return a.between(10000000, 99999999)
+	},
+	"D9": func(a accountNo) bool { return method00(a) || method10(a) || method18(a) },
+	"E0": func(a accountNo) bool { return (10-(a.sum(1, 9, w21, true)+7)%10)%10 == a.pos(10) },
+	"E1": func(a accountNo) bool {
+		// The digits are weighted with their ASCII codes.
+		s := 0
+		for i, w := range []int{1, 2, 3, 4, 5, 6, 11, 10, 9} {
+			s += ('0' + a.pos(9-i)) * w
+		}
+		r := s % 11
+		return r != 10 && r == a.pos(10)
+	},
+	"E2": func(a accountNo) bool {
+		if a.pos(1) > 5 {
+			return false
+		}
+		return a.mod10Prefix(fmt.Sprintf("438320%d", a.pos(1)), 2)
+	},
+	"E3": func(a accountNo) bool { return method00(a) || method21(a) },
+	"E4": func(a accountNo) bool { return method02(a) || method00(a) },
+}
+
+// bankCodeMethods contains the implemented check methods of the Deutsche Bundesbank
+// that also depend on the bank code.
+var bankCodeMethods = map[string]func(bc string, a accountNo) bool{
+	"52": method52,
+	"53": method53,
+	"B6": func(bc string, a accountNo) bool {
+		if a.pos(1) != 0 || a.between(269100000, 269999999) {
+			return method20(a)
+		}
+		return method53(bc, a)
+	},
+	"C0": func(bc string, a accountNo) bool {
+		if a.pos(1) == 0 && a.pos(2) == 0 && a.pos(3) != 0 && method52(bc, a) {
+			return true
+		}
+		return method20(a)
+	},
+}
+
+func method00(a accountNo) bool { return a.mod10(1, 9, 10, w21, true) }
+func method01(a accountNo) bool { return a.mod10(1, 9, 10, w371, false) }
+func method02(a accountNo) bool { return a.mod11Strict(1, 9, 10, w2to9) }
+func method03(a accountNo) bool { return a.mod10(1, 9, 10, w21, false) }
+func method04(a accountNo) bool { return a.mod11Strict(1, 9, 10, w2to7) }
+func method05(a accountNo) bool { return a.mod10(1, 9, 10, w731, false) }
+func method06(a accountNo) bool { return a.mod11(1, 9, 10, w2to7) }
+func method07(a accountNo) bool { return a.mod11Strict(1, 9, 10, w2to10) }
+func method10(a accountNo) bool { return a.mod11(1, 9, 10, w2to10) }
+func method18(a accountNo) bool { return a.mod10(1, 9, 10, w3971, false) }
+func method19(a accountNo) bool { return a.mod11(1, 9, 10, []int{2, 3, 4, 5, 6, 7, 8, 9, 1}) }
+func method20(a accountNo) bool { return a.mod11(1, 9, 10, w2to9And) }
+func method32(a accountNo) bool { return a.mod11(4, 9, 10, w2to7) }
+
+// method17 checks the six digit customer number at positions 2 to 7 with the check digit at position 8.
+func method17(a accountNo) bool {
+	r := (a.sum(2, 7, w21, true) - 1) % 11
+	if r == 0 {
+		return a.pos(8) == 0
+	}
+	return 10-r == a.pos(8)
+}
+
+// method21 repeats the cross sum of the sum until it has one digit.
+func method21(a accountNo) bool {
+	s := a.sum(1, 9, w21, true)
+	for s > 9 {
+		s = s/10 + s%10
+	}
+	return (10-s)%10 == a.pos(10)
+}
+
+// method22 only sums up the last digits of the products.
+func method22(a accountNo) bool {
+	s := 0
+	for i, j := 9, 0; i >= 1; i, j = i-1, j+1 {
+		s += a.pos(i) * []int{3, 1}[j%2] % 10
+	}
+	return (10-s%10)%10 == a.pos(10)
+}
+
+// method24 weights the digits from the first digit that is not zero.
+func method24(a accountNo) bool {
+	b := a
+	switch b.pos(1) {
+	case 3, 4, 5, 6:
+		b[0] = 0
+	case 9:
+		b[0], b[1], b[2] = 0, 0, 0
+	}
+	s, w := 0, 0
+	for i := 1; i <= 9; i++ {
+		if w == 0 && b.pos(i) == 0 {
+			continue
+		}
+		g := w%3 + 1
+		s += (b.pos(i)*g + g) % 11
+		w++
+	}
+	return s%10 == a.pos(10)
+}
+
+// m10h is the transformation table of the iterated transformation of method 29.
+var m10h = [4][10]int{
+	{0, 1, 5, 9, 3, 7, 4, 8, 2, 6},
+	{0, 1, 7, 6, 9, 8, 3, 2, 5, 4},
+	{0, 1, 8, 4, 6, 2, 9, 5, 7, 3},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+}
+
+func method29(a accountNo) bool {
+	s := 0
+	for i, j := 9, 0; i >= 1; i, j = i-1, j+1 {
+		s += m10h[j%4][a.pos(i)]
+	}
+	return (10-s%10)%10 == a.pos(10)
+}
+
+// w52 are the weights of the account numbers of the former ESER system of the savings banks.
+var w52 = []int{2, 4, 8, 5, 10, 9, 7, 3, 6, 1, 2, 4}
+
+// eser checks an account number of the former ESER system of the savings banks,
+// whose check digit is always the 6th digit.
+func eser(alt []int) bool {
+	s, f := 0, 0
+	for i, j := len(alt)-1, 0; i >= 0; i, j = i-1, j+1 {
+		if i == 5 {
+			f = w52[j]
+			continue
+		}
+		s += alt[i] * w52[j]
+	}
+	for p := 0; p < 10; p++ {
+		if (s+f*p)%11 == 10 {
+			return p == alt[5]
+		}
+	}
+	return false
+}
+
+// eserAccountNo returns the account number in the ESER system, which starts with the digits of
+// the bank code and the account number in the prefix and ends with the digits from position 5
+// without leading zeros.
+func eserAccountNo(prefix []int, a accountNo) []int {
+	i := 5
+	for i < 10 && a.pos(i) == 0 {
+		i++
+	}
+	return append(prefix, a[i-1:]...)
+}
+
+// method52 checks eight digit account numbers in the ESER system.
+func method52(bc string, a accountNo) bool {
+	if a.pos(1) == 9 {
+		return method20(a)
+	}
+	if len(bc) != 8 || a.pos(1) != 0 || a.pos(2) != 0 {
+		return false
+	}
+	return eser(eserAccountNo([]int{digit(bc, 5), digit(bc, 6), digit(bc, 7), digit(bc, 8), a.pos(3), a.pos(4)}, a))
+}
+
+// method53 checks nine digit account numbers in the ESER system.
+func method53(bc string, a accountNo) bool {
+	if a.pos(1) == 9 {
+		return method20(a)
+	}
+	if len(bc) != 8 || a.pos(1) != 0 {
+		return false
+	}
+	return eser(eserAccountNo([]int{digit(bc, 5), digit(bc, 6), a.pos(3), digit(bc, 8), a.pos(2), a.pos(4)}, a))
+}
+
+// digit returns the digit at the position of the bank code, counted from 1 like positions of account numbers.
+func digit(bc string, i int) int {
+	return int(bc[i-1] - '0')
+}
+
+// method57 depends on the first two digits, which also determine the position of the check digit.
+func method57(a accountNo) bool {
+	switch p := a.prefix(2); {
+	case p == 0:
+		return false
+	case p <= 31:
+		// The digits 3 and 4 are a month and the digits 7 to 9 are below 500.
+		m := a.prefix(4) % 100
+		return (m >= 1 && m <= 12 && a.prefix(9)%1000 < 500) || a.prefix(10) == 185125434
+	case p == 40, p == 50, p == 91, p == 99:
+		return true
+	case p == 51, p == 55, p == 61, p == 64, p == 65, p == 66, p == 70, p >= 73 && p <= 82, p == 88, p == 94, p == 95:
+		if a.prefix(6) == 777777 || a.prefix(6) == 888888 {
+			return true
+		}
+		return a.mod10(1, 9, 10, w12, true)
+	}
+	// The check digit is at position 3.
+	s := 0
+	for j, i := range []int{1, 2, 4, 5, 6, 7, 8, 9, 10} {
+		p := a.pos(i) * w12[j%2]
+		s += p/10 + p%10
+	}
+	return (10-s%10)%10 == a.pos(3)
+}
+
+// method61 also checks the positions 9 and 10 if the digit at position 9 is sub.
+func method61(a accountNo, sub int) bool {
+	s := a.sum(1, 7, w21, true)
+	if a.pos(9) == sub {
+		s += a.pos(9) + a.sum(10, 10, []int{2}, true)
+	}
+	return (10-s%10)%10 == a.pos(8)
+}
+
+func method63(a accountNo) bool {
+	if a.pos(1) != 0 {
+		return false
+	}
+	if a.mod10(2, 7, 8, w21, true) {
+		return true
+	}
+	return a.pos(2) == 0 && a.pos(3) == 0 && a.shift().mod10(2, 7, 8, w21, true)
+}
+
+func method68(a accountNo) bool {
+	if a.pos(1) != 0 {
+		// Ten digit account numbers have a 9 at position 4 and only the positions 4 to 9 are checked.
+		return a.pos(4) == 9 && a.mod10(4, 9, 10, w21, true)
+	}
+	if a.between(400000000, 499999999) {
+		return true
+	}
+	if a.between(0, 99999) {
+		return false
+	}
+	// The 7th and 8th digits from the right can also be left out.
+	b := a
+	b[2], b[3] = 0, 0
+	return method00(a) || method00(b)
+}
+
+// method75 checks six, seven and nine digit account numbers.
+func method75(a accountNo) bool {
+	switch {
+	case a.between(100000, 9999999):
+		return a.mod10(5, 9, 10, w21, true)
+	case a.between(100000000, 999999999):
+		if a.pos(2) == 9 {
+			return a.mod10(3, 7, 8, w21, true)
+		}
+		return a.mod10(2, 6, 7, w21, true)
+	}
+	return false
+}
+
+func method76(a accountNo) bool {
+	switch a.pos(1) {
+	case 0, 4, 6, 7, 8, 9:
+	default:
+		return false
+	}
+	r := a.sum(2, 7, w2to7, false) % 11
+	return r != 10 && r == a.pos(8)
+}
+
+var (
+	tab87a = [5]int{0, 4, 3, 2, 6}
+	tab87b = [5]int{7, 1, 5, 9, 8}
+)
+
+// method87 is method A of check method 87, which follows the pseudocode of the specification.
+func method87(a accountNo) bool {
+	i := 4
+	for i < 10 && a.pos(i) == 0 {
+		i++
+	}
+	c2, d2, a5 := i%2, 0, 0
+	for ; i < 10; i++ {
+		z := a.pos(i)
+		switch z {
+		case 0:
+			z = 5
+		case 1:
+			z = 6
+		case 5:
+			z = 10
+		case 6:
+			z = 1
+		}
+		switch {
+		case c2 == d2 && z > 5:
+			if c2 == 0 {
+				c2, d2 = 1, 1
+				a5 += 6 - (z - 6)
+			} else {
+				c2, d2 = 0, 0
+				a5 += z
+			}
+		case c2 == d2:
+			c2 = 1 - c2
+			a5 += z
+		case z > 5:
+			if c2 == 0 {
+				c2, d2 = 1, 0
+				a5 += z - 12
+			} else {
+				c2, d2 = 0, 1
+				a5 -= z
+			}
+		default:
+			c2 = 1 - c2
+			a5 -= z
+		}
+	}
+	for a5 < 0 || a5 > 4 {
+		if a5 > 4 {
+			a5 -= 5
+		} else {
+			a5 += 5
+		}
+	}
+	p := tab87a[a5]
+	if d2 != 0 {
+		p = tab87b[a5]
+	}
+	if p == a.pos(10) {
+		return true
+	}
+	if a.pos(4) == 0 {
+		return (p+5)%10 == a.pos(10)
+	}
+	return false
+}
+
+func method95(a accountNo) bool {
+	return a.between(1, 1999999) ||
+		a.between(9000000, 25999999) ||
+		a.between(396000000, 499999999) ||
+		a.between(700000000, 799999999) ||
+		a.between(910000000, 989999999) ||
+		method06(a)
+}
+
+// ledger checks the ledger account numbers with a 9 at position 3 of methods 51, 73, 81, 84, 87 and A8.
+func ledger(a accountNo) bool {
+	return a.mod11(3, 9, 10, w2to8) || a.mod11(1, 9, 10, w2to10)
+}
+
+// CheckMethods returns the implemented check methods of the Deutsche Bundesbank.
+func CheckMethods() []string {
+	ret := make([]string, 0, len(checkMethods)+len(bankCodeMethods))
+	for m := range checkMethods {
+		ret = append(ret, m)
+	}
+	for m := range bankCodeMethods {
+		ret = append(ret, m)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// CheckAccountNo checks a German account number with the given check method
+// of the Deutsche Bundesbank. Methods 52, 53, B6 and C0 also depend on the bank code
+// and reject account numbers that need it, see CheckBankAccountNo.
+func CheckAccountNo(method, aNo string) error {
+	return CheckBankAccountNo(method, "", aNo)
+}
+
+// CheckBankAccountNo checks the German account number of the bank with the bank code bc
+// with the given check method of the Deutsche Bundesbank.
+func CheckBankAccountNo(method, bc, aNo string) error {
+	if _, err := checked(method); err != nil {
+		return err
+	}
+	a, err := parseAccountNo(aNo)
+	if err != nil {
+		return err
+	}
+	ok := false
+	if f, isBankCodeMethod := bankCodeMethods[method]; isBankCodeMethod {
+		ok = f(bc, a)
+	} else {
+		ok = checkMethods[method](a)
+	}
+	if !ok {
+		return fmt.Errorf("%w for method %s", ErrAccountCheckDigit, method)
+	}
+	return nil
+}
+
+func parseAccountNo(s string) (accountNo, error) {
+	var a accountNo
+	if len(s) == 0 || len(s) > len(a) {
+		return a, ErrMalformedAccountNo
+	}
+	if _, err := strconv.ParseUint(s, 10, 64); err != nil {
+		return a, ErrMalformedAccountNo
+	}
+	for i, j := len(s)-1, len(a)-1; i >= 0; i, j = i-1, j-1 {
+		a[j] = int(s[i] - '0')
+	}
+	return a, nil
+}
+
+// maxAttempts is the maximum number of random account numbers that are
+// tried to find one that passes a check method.
+const maxAttempts = 10000
+
+// checked reports whether German account numbers are checked with the method.
+// An empty method stands for a bank without a known check method, whose account numbers are not checked;
+// ErrUnsupportedMethod is returned for methods that are not implemented.
+func checked(method string) (bool, error) {
+	if method == "" {
+		return false, nil
+	}
+	_, ok := checkMethods[method]
+	if _, isBankCodeMethod := bankCodeMethods[method]; !ok && !isBankCodeMethod {
+		return false, fmt.Errorf("%w %q", ErrUnsupportedMethod, method)
+	}
+	return true, nil
+}
+
+// randomAccountNo returns a random German account number of the bank with the bank code bc
+// that passes the check method. For an empty method any account number is returned.
+func (g *Generator) randomAccountNo(method, bc string) (string, error) {
+	p := countries[CountryCodeDE].accountPattern()
+	if ok, err := checked(method); err != nil {
+		return "", err
+	} else if !ok {
+		return g.randomString(p), nil
+	}
+	for i := 0; i < maxAttempts; i++ {
+		if aNo := g.randomString(p); CheckBankAccountNo(method, bc, aNo) == nil {
+			return aNo, nil
+		}
+	}
+	return "", fmt.Errorf("failed to find an account number for check method %s", method)
+}
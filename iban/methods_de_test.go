@@ -0,0 +1,223 @@
+package iban
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+// bundesbankFile is the bank data that iban-gen embeds.
+const bundesbankFile = "../cmd/iban-gen/data/bundesbank.txt"
+
+func TestCheckAccountNo(t *testing.T) {
+	tested := make(map[string]bool)
+	for _, tc := range []struct {
+		method   string
+		bankCode string
+		valid    []string
+		invalid  []string
+	}{
+		{method: "00", valid: []string{"9290701", "539290858", "1501824", "1501832"}, invalid: []string{"9290702"}},
+		{method: "01", valid: []string{"5260181599", "0830166138"}, invalid: []string{"5260181590"}},
+		{method: "02", valid: []string{"1860913900", "9960308249"}, invalid: []string{"1860913901"}},
+		{method: "03", valid: []string{"6281948217", "9935181901"}, invalid: []string{"6281948218"}},
+		{method: "04", valid: []string{"9378657978", "5432319484"}, invalid: []string{"9378657979"}},
+		{method: "05", valid: []string{"7574911864", "2527601896"}, invalid: []string{"7574911865"}},
+		{method: "06", valid: []string{"5559797113", "4710497465"}, invalid: []string{"5559797114"}},
+		{method: "07", valid: []string{"5075291708", "3423667125"}, invalid: []string{"5075291709"}},
+		{method: "08", valid: []string{"59999", "9290701"}, invalid: []string{"9290702"}},
+		{method: "09", valid: []string{"1234567890", "9999999999"}},
+		{method: "10", valid: []string{"12345008", "87654008"}},
+		{method: "11", valid: []string{"0109281594", "0139624597"}, invalid: []string{"0109281595"}},
+		{method: "13", valid: []string{"0532013000"}},
+		{method: "16", valid: []string{"5944610922", "4834352619"}, invalid: []string{"5944610923"}},
+		{method: "17", valid: []string{"0446786040", "1234631242"}, invalid: []string{"0446786140"}},
+		{method: "18", valid: []string{"1234575809", "1234654999"}, invalid: []string{"1234575800"}},
+		{method: "19", valid: []string{"1234821298", "1234868812"}, invalid: []string{"1234821290"}},
+		{method: "20", valid: []string{"7684268460", "5632122338"}, invalid: []string{"7684268461"}},
+		{method: "21", valid: []string{"1234662918", "1234868812"}, invalid: []string{"1234662910"}},
+		{method: "22", valid: []string{"1234591647", "1234607485"}, invalid: []string{"1234591640"}},
+		{method: "23", valid: []string{"1234757946", "1234765865"}, invalid: []string{"1234750946"}},
+		{method: "24", valid: []string{"138301", "1306118605", "3307118608", "9307118603"}, invalid: []string{"138300"}},
+		{method: "25", valid: []string{"521382181", "1234797541"}, invalid: []string{"521382180"}},
+		{method: "26", valid: []string{"0520309001", "1111118111", "0005501024"}, invalid: []string{"0520309101"}},
+		{method: "27", valid: []string{"2847169488", "1234583728"}, invalid: []string{"2847169480"}},
+		{method: "28", valid: []string{"19999000", "9130000201"}},
+		{method: "29", valid: []string{"3145863029", "1234583728"}, invalid: []string{"3145863020"}},
+		{method: "30", valid: []string{"1234623323", "1234670837"}, invalid: []string{"1234623320"}},
+		{method: "31", valid: []string{"1000000524", "1000000583"}, invalid: []string{"1000000520"}},
+		{method: "32", valid: []string{"9141405", "1709107983", "122116979", "121114867", "9030101192", "9245500460"}},
+		{method: "33", valid: []string{"48658", "84956"}},
+		{method: "34", valid: []string{"9913000700", "9914001000"}},
+		{method: "38", valid: []string{"191919", "1100660"}},
+		{method: "40", valid: []string{"1234567890", "1234575809"}, invalid: []string{"1234567891"}},
+		{method: "41", valid: []string{"4013410024", "4016660195", "0166805317", "4019310079"}, invalid: []string{"4013410020"}},
+		{method: "42", valid: []string{"59498", "59510"}, invalid: []string{"59490"}},
+		{method: "43", valid: []string{"0792440263", "8599528906"}, invalid: []string{"0792440264"}},
+		{method: "44", valid: []string{"889006", "2618040504"}, invalid: []string{"889000"}},
+		{method: "46", valid: []string{"0235468612", "0837890901", "1041447600"}, invalid: []string{"0235468012"}},
+		{method: "47", valid: []string{"1018000", "1003554450"}, invalid: []string{"1018010"}},
+		{method: "48", valid: []string{"1234757946", "1234845055"}, invalid: []string{"1234757906"}},
+		{method: "49", valid: []string{"1234575809", "1234615404"}, invalid: []string{"1234575800"}},
+		{method: "50", valid: []string{"4000005001", "4444442001"}, invalid: []string{"4000000001"}},
+		{method: "51", valid: []string{"0001156071", "0001234567", "1009588", "0199100002"}, invalid: []string{"0001156070"}},
+		{method: "52", bankCode: "13051172", valid: []string{"43001500", "9000034318"}, invalid: []string{"43001501"}},
+		{method: "53", bankCode: "16052072", valid: []string{"382432256", "9000034318"}, invalid: []string{"382432250"}},
+		{method: "55", valid: []string{"1234805460", "1234876731"}, invalid: []string{"1234805461"}},
+		{method: "56", valid: []string{"0290545005", "9718304037"}, invalid: []string{"0290545000"}},
+		{method: "57", valid: []string{"7500021766", "7800028282", "3251080371", "7777778800"}, invalid: []string{"7500021760"}},
+		{method: "59", valid: []string{"1234575809", "1234773784"}, invalid: []string{"1234575800"}},
+		{method: "60", valid: []string{"7866661760", "0313721592"}, invalid: []string{"7866661761"}},
+		{method: "61", valid: []string{"2063099200", "0260760481"}},
+		{method: "63", valid: []string{"123456600", "1234566"}},
+		{method: "64", valid: []string{"1206473010", "5016511020"}, invalid: []string{"1206470010"}},
+		{method: "65", valid: []string{"1234567400", "1234567590"}, invalid: []string{"1234567490"}},
+		{method: "66", valid: []string{"100154508", "101154508", "100154516", "101154516"}, invalid: []string{"100154500"}},
+		{method: "67", valid: []string{"1234639161", "1234718351"}, invalid: []string{"1234639061"}},
+		{method: "68", valid: []string{"8889654328", "987654324", "987654328", "400000000"}, invalid: []string{"8889654320"}},
+		{method: "71", valid: []string{"7101234007", "1234567890"}, invalid: []string{"7101234000"}},
+		{method: "73", valid: []string{"0003503398", "0001340967", "0003503391", "0001340968"}, invalid: []string{"0003503390", "121212"}},
+		{method: "74", valid: []string{"1016", "26260", "242243", "242248"}, invalid: []string{"1010", "26265"}},
+		{method: "76", valid: []string{"0006543200", "9012345600", "7876543100"}},
+		{method: "78", valid: []string{"7581499", "9999999981"}, invalid: []string{"7581490"}},
+		{method: "81", valid: []string{"1234583728", "1234631242"}, invalid: []string{"1234583720"}},
+		{method: "84", valid: []string{"240699", "461059", "350985", "3199100002"}, invalid: []string{"240690"}},
+		{method: "85", valid: []string{"0001156071", "0001156136", "0001156078", "0001234567"}, invalid: []string{"0001156070"}},
+		{method: "87", valid: []string{"0000000406", "0010701590", "0000100005", "0000950360"}, invalid: []string{"0000000403"}},
+		{method: "88", valid: []string{"2525259", "1000500", "90013000", "92525253", "99913003"}},
+		{method: "91", valid: []string{"2974118000", "2974117000", "8840019000", "8840045000"}, invalid: []string{"2974110000"}},
+		{method: "92", valid: []string{"1234575809", "1234607485"}, invalid: []string{"1234575800"}},
+		{method: "94", valid: []string{"6782533003", "1234607485"}, invalid: []string{"6782533000"}},
+		{method: "95", valid: []string{"1999998", "5195537218"}, invalid: []string{"5195537219"}},
+		{method: "96", valid: []string{"0000254100", "9421000009", "0000000208", "0101115152"}, invalid: []string{"0000254101"}},
+		{method: "98", valid: []string{"9619439213", "3009800016", "9619509976", "5989800173"}, invalid: []string{"9619439210"}},
+		{method: "99", valid: []string{"0068007003", "0847321750"}},
+		{method: "A2", valid: []string{"3456789012", "5678901231", "6789012348", "3456789019"}},
+		{method: "A3", valid: []string{"9290701", "5397431087"}, invalid: []string{"5397431081"}},
+		{method: "A4", valid: []string{"0004711173", "0004711172", "1199503010", "0000862342"}, invalid: []string{"0004711170"}},
+		{method: "A5", valid: []string{"9941510001", "9961230019", "9380027210", "9932290910", "0000251437", "0007948344", "0000159590", "0000051640"}},
+		{method: "A6", valid: []string{"1234615404", "1234702513"}, invalid: []string{"1234615400"}},
+		{method: "A7", valid: []string{"1234567890", "1234575809"}, invalid: []string{"1234567891"}},
+		{method: "A8", valid: []string{"7436661", "1359100", "0001340967", "0099100010"}, invalid: []string{"7436662"}},
+		{method: "B1", valid: []string{"1434253150", "2746315471", "7414398260", "8347251693"}, invalid: []string{"1434253151"}},
+		{method: "B2", valid: []string{"0020012357", "0080012345", "0926801910", "1002345674"}, invalid: []string{"0020012350"}},
+		{method: "B3", valid: []string{"1000000060", "0140000019", "1002798417", "8409915001"}, invalid: []string{"1000000061"}},
+		{method: "B5", valid: []string{"0159006955", "2000123451", "1151043216", "9000939033"}, invalid: []string{"0159006950"}},
+		{method: "B6", bankCode: "80053762", valid: []string{"487310018", "9110000000", "0269876545"}, invalid: []string{"487310010"}},
+		{method: "B7", valid: []string{"0700001529", "0730000019", "0001001008", "0001057887", "0001007222", "0810011825", "0800107653", "0005922372"}},
+		{method: "B8", valid: []string{"0734192657", "6932875274", "3145863029", "2938692523"}, invalid: []string{"0734192650"}},
+		{method: "C0", bankCode: "13051172", valid: []string{"43001500", "0082335729", "0734192657", "6932875274"}, invalid: []string{"43001501"}},
+		{method: "C1", valid: []string{"0446786040", "0701625830", "0882095630", "5543223456"}, invalid: []string{"0446786140"}},
+		{method: "C2", valid: []string{"2394871426", "4218461950", "7352569148", "5127485166"}, invalid: []string{"2394871420"}},
+		{method: "C3", valid: []string{"9294182", "4431276", "19919", "9000420530"}, invalid: []string{"9294180"}},
+		{method: "C5", valid: []string{"0000301168", "0300566000", "4450164064", "3070402023"}, invalid: []string{"0000301160"}},
+		{method: "C6", valid: []string{"0000065516", "2004001016", "4012660028", "7002000023"}, invalid: []string{"0000065510"}},
+		{method: "C7", valid: []string{"3500022", "38150900", "600103660", "39101181"}, invalid: []string{"3500021"}},
+		{method: "C8", valid: []string{"3456789019", "5678901231"}},
+		{method: "C9", valid: []string{"3456789019", "5678901231", "0123456789"}, invalid: []string{"3456789010"}},
+		{method: "D0", valid: []string{"6100272324", "6100273479", "5700000000"}, invalid: []string{"6100272320"}},
+		{method: "D1", valid: []string{"0082012203", "1452683581", "2129642505", "3002000027"}, invalid: []string{"0082012200"}},
+		{method: "D2", valid: []string{"189912137", "235308215", "4455667784", "1234567897"}, invalid: []string{"189912130"}},
+		{method: "D4", valid: []string{"1112048219", "3000005012", "5926485111", "7900256617"}, invalid: []string{"1112048210"}},
+		{method: "D6", valid: []string{"3409", "1650513", "4402001046", "7001000681"}, invalid: []string{"3401"}},
+		{method: "D7", valid: []string{"0500018205", "0301000434", "0420001202", "0201005939"}, invalid: []string{"0500018200"}},
+		{method: "D8", valid: []string{"1403414848", "6800000439", "6899999954", "0010000000"}, invalid: []string{"1403414840"}},
+		{method: "D9", valid: []string{"1234567897", "0123456782", "9876543210", "1234567890"}, invalid: []string{"1234567891"}},
+		{method: "E0", valid: []string{"1234568013", "1534568010", "2610015", "8741013011"}, invalid: []string{"1234568010"}},
+		{method: "E1", valid: []string{"0134211909", "0100041104", "0100054106", "0200025107"}, invalid: []string{"0134211900", "0150013107"}},
+		{method: "E2", valid: []string{"0003831745", "0051330335", "3000135011"}, invalid: []string{"0003831740"}},
+		{method: "E3", valid: []string{"9290701", "539290858", "1501824", "1501832"}, invalid: []string{"9290700"}},
+		{method: "E4", valid: []string{"1234575809", "1234583728"}, invalid: []string{"1234575800"}},
+	} {
+		for _, aNo := range tc.valid {
+			if err := CheckBankAccountNo(tc.method, tc.bankCode, aNo); err != nil {
+				t.Errorf("%s %s: got err=%q\n", tc.method, aNo, err.Error())
+			}
+		}
+		for _, aNo := range tc.invalid {
+			if err := CheckBankAccountNo(tc.method, tc.bankCode, aNo); !errors.Is(err, ErrAccountCheckDigit) {
+				t.Errorf("%s %s: got err=%v expected=%v\n", tc.method, aNo, err, ErrAccountCheckDigit)
+			}
+		}
+		tested[tc.method] = true
+	}
+	for _, m := range CheckMethods() {
+		if !tested[m] {
+			t.Errorf("%s: got no test vectors\n", m)
+		}
+	}
+	if err := CheckAccountNo("00", "9290702"); !errors.Is(err, ErrAccountCheckDigit) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrAccountCheckDigit)
+	}
+	if err := CheckAccountNo("00", "12345678901"); !errors.Is(err, ErrMalformedAccountNo) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrMalformedAccountNo)
+	}
+	if err := CheckAccountNo("ZZ", "9290701"); !errors.Is(err, ErrUnsupportedMethod) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrUnsupportedMethod)
+	}
+	// Account numbers of the ESER system cannot be checked without the bank code.
+	if err := CheckAccountNo("C0", "43001500"); !errors.Is(err, ErrAccountCheckDigit) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrAccountCheckDigit)
+	}
+	if err := CheckBankAccountNo("C0", "13051172", "43001500"); err != nil {
+		t.Errorf("got err=%q\n", err.Error())
+	}
+}
+
+func TestCheckMethodsOfBankData(t *testing.T) {
+	f, err := os.Open(bundesbankFile)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	defer f.Close()
+	banks := make(map[string]int)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// The check method is at the same position as in bic.BankRepo.Populate.
+		r := []rune(s.Text())
+		if len(r) < 168 {
+			t.Fatalf("got a record with %d characters\n", len(r))
+		}
+		if m := strings.TrimSpace(string(r[150:152])); m != "" {
+			banks[m]++
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if len(banks) == 0 {
+		t.Fatal("got no check methods\n")
+	}
+	for m, n := range banks {
+		if _, err := checked(m); err != nil {
+			t.Errorf("%s: got err=%q for %d records\n", m, err.Error(), n)
+		}
+	}
+}
+
+func TestGenerateFromBankCodeAndMethod(t *testing.T) {
+	for _, m := range CheckMethods() {
+		i, err := GenerateFromBankCodeAndMethod(CountryCodeDE, "10070000", m)
+		if err != nil {
+			t.Errorf("%s: got err=%q\n", m, err.Error())
+			continue
+		}
+		if err := CheckAccountNo(m, i.AccountNo()); err != nil {
+			t.Errorf("%s: %s got err=%q\n", m, i.AccountNo(), err.Error())
+		}
+	}
+	if _, err := GenerateFromBankCodeAndMethod(CountryCodeDE, "10070000", ""); err != nil {
+		t.Errorf("got err=%q\n", err.Error())
+	}
+	if _, err := GenerateFromBankCodeAndMethod(CountryCodeDE, "10070000", "ZZ"); !errors.Is(err, ErrUnsupportedMethod) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrUnsupportedMethod)
+	}
+	if _, err := GenerateFromTemplate("DE**10070000", "ZZ"); !errors.Is(err, ErrUnsupportedMethod) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrUnsupportedMethod)
+	}
+	if _, err := NewEnumerator(CountryCodeDE, "10070000", "ZZ"); !errors.Is(err, ErrUnsupportedMethod) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrUnsupportedMethod)
+	}
+}
@@ -0,0 +1,156 @@
+package iban
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// nationalChecks contains functions that set the national check digits of a BBAN.
+// They return false if no valid check digits exist for the BBAN.
+var nationalChecks = map[CountryCode]func(bban string) (string, bool){
+	"BE": checkBE,
+	"ES": checkES,
+	"FI": checkFI,
+	"FR": checkFR,
+	"GB": checkGB,
+	"IT": checkIT,
+	"MC": checkFR,
+	"NO": checkNO,
+	"PT": checkPT,
+	"SM": checkIT,
+}
+
+// nationalCheckDigits contains the [start, end) position of the national check digits in the BBAN
+// for countries whose check digits are computed by nationalChecks.
+var nationalCheckDigits = map[CountryCode][2]int{
+	"BE": {10, 12},
+	"ES": {8, 10},
+	"FI": {13, 14},
+	"FR": {21, 23},
+	"IT": {0, 1},
+	"MC": {21, 23},
+	"NO": {10, 11},
+	"PT": {19, 21},
+	"SM": {0, 1},
+}
+
+// withNationalCheckDigits returns the BBAN with the national check digits of the country.
+// BBANs of countries without national check digits are returned unchanged.
+func withNationalCheckDigits(cc CountryCode, bban string) (string, bool) {
+	f, ok := nationalChecks[cc]
+	if !ok {
+		return bban, true
+	}
+	return f(bban)
+}
+
+// checkBE sets the Belgian check digits, which are the first ten digits mod 97.
+func checkBE(bban string) (string, bool) {
+	r := mod97(bban[:10])
+	if r == 0 {
+		r = 97
+	}
+	return fmt.Sprintf("%s%02d", bban[:10], r), true
+}
+
+var weightsES = []int{1, 2, 4, 8, 5, 10, 9, 7, 3, 6}
+
+// checkES sets the Spanish "dígitos de control" of bank and branch and of the account number.
+func checkES(bban string) (string, bool) {
+	dc := func(s string) int {
+		sum := 0
+		for i := range s {
+			sum += int(s[i]-'0') * weightsES[i]
+		}
+		switch d := 11 - sum%11; d {
+		case 11:
+			return 0
+		case 10:
+			return 1
+		default:
+			return d
+		}
+	}
+	return fmt.Sprintf("%s%d%d%s", bban[:8], dc("00"+bban[:8]), dc(bban[10:]), bban[10:]), true
+}
+
+// checkFI sets the Finnish check digit with the Luhn algorithm.
+func checkFI(bban string) (string, bool) {
+	sum := 0
+	for i, j := len(bban)-2, 0; i >= 0; i, j = i-1, j+1 {
+		d := int(bban[i] - '0')
+		if j%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return fmt.Sprintf("%s%d", bban[:len(bban)-1], (10-sum%10)%10), true
+}
+
+// checkFR sets the French "clé RIB".
+func checkFR(bban string) (string, bool) {
+	account := make([]byte, 11)
+	for i := range account {
+		c := bban[10+i]
+		switch {
+		case c >= 'A' && c <= 'I':
+			c = c - 'A' + '1'
+		case c >= 'J' && c <= 'R':
+			c = c - 'J' + '1'
+		case c >= 'S' && c <= 'Z':
+			c = c - 'S' + '2'
+		}
+		account[i] = c
+	}
+	bank, _ := strconv.Atoi(bban[:5])
+	branch, _ := strconv.Atoi(bban[5:10])
+	a, _ := strconv.Atoi(string(account))
+	key := 97 - (89*bank+15*branch+3*a)%97
+	return fmt.Sprintf("%s%02d", bban[:21], key), true
+}
+
+// oddIT contains the values of characters at odd positions for the Italian CIN.
+var oddIT = []int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23}
+
+// checkIT sets the Italian CIN, which is the first character of the BBAN.
+func checkIT(bban string) (string, bool) {
+	sum := 0
+	for i := 1; i < len(bban); i++ {
+		v := int(bban[i] - '0')
+		if bban[i] >= 'A' {
+			v = int(bban[i] - 'A')
+		}
+		if i%2 == 1 {
+			v = oddIT[v]
+		}
+		sum += v
+	}
+	return string(rune('A'+sum%26)) + bban[1:], true
+}
+
+var weightsNO = []int{5, 4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+// checkNO sets the Norwegian mod-11 check digit.
+// Accounts with remainder 1 have no valid check digit.
+func checkNO(bban string) (string, bool) {
+	sum := 0
+	for i, w := range weightsNO {
+		sum += int(bban[i]-'0') * w
+	}
+	switch r := sum % 11; r {
+	case 0:
+		return bban[:10] + "0", true
+	case 1:
+		return bban, false
+	default:
+		return fmt.Sprintf("%s%d", bban[:10], 11-r), true
+	}
+}
+
+// checkPT sets the Portuguese NIB check digits.
+func checkPT(bban string) (string, bool) {
+	return fmt.Sprintf("%s%02d", bban[:19], 98-mod97(bban[:19]+"00")), true
+}
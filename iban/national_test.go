@@ -0,0 +1,47 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNationalCheckDigits(t *testing.T) {
+	for _, in := range []string{
+		"BE68539007547034",
+		"ES9121000418450200051332",
+		"FI2112345600000785",
+		"FR1420041010050500013M02606",
+		"IT60X0542811101000000123456",
+		"MC5811222000010123456789030",
+		"NO9386011117947",
+		"PT50000201231234567890154",
+		"SM86U0322509800000000270100",
+	} {
+		if _, err := Parse(in); err != nil {
+			t.Errorf("%s: got err=%q\n", in, err.Error())
+		}
+	}
+}
+
+func TestNationalCheckDigitsInvalid(t *testing.T) {
+	for cc, bban := range map[CountryCode]string{
+		"BE": "539007547035",
+		"ES": "21000418460200051332",
+		"FI": "12345600000786",
+		"FR": "20041010050500013M02607",
+		"IT": "Y0542811101000000123456",
+		"MC": "11222000010123456789031",
+		"NO": "86011117948",
+		"PT": "000201231234567890155",
+		"SM": "V0322509800000000270100",
+	} {
+		bc, aNo := countries[cc].split(bban)
+		i, err := IBAN{bc: bc, aNo: aNo, cc: cc}.check()
+		if err != nil {
+			t.Fatalf("%s: got err=%q\n", cc, err.Error())
+		}
+		if _, err := Parse(i.String()); !errors.Is(err, ErrNationalCheckDigit) {
+			t.Errorf("%s: got err=%v expected=%v\n", i.String(), err, ErrNationalCheckDigit)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package iban
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize turns user input into the electronic format of an IBAN.
+// It removes whitespace, separators like dashes, dots and slashes and a leading "IBAN" or "IBAN:" prefix
+// and converts letters to upper case, e.g. " iban: de89-3704-0044-0532-0130-00" becomes "DE89370400440532013000".
+// The result is not validated; use Parse for that.
+func Normalize(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || isSeparator(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, s)
+	// No country code starts with IB, so the prefix is never part of an IBAN.
+	s = strings.TrimPrefix(s, "IBAN")
+	return strings.TrimPrefix(s, ":")
+}
+
+// ParseLenient parses an IBAN after normalizing it with Normalize.
+// The returned error is an *Error.
+func ParseLenient(s string) (*IBAN, error) {
+	return Parse(Normalize(s))
+}
+
+func isSeparator(r rune) bool {
+	switch r {
+	case '-', '.', '/', '_':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		out string
+	}{
+		{in: "DE89370400440532013000", out: "DE89370400440532013000"},
+		{in: "DE89 3704 0044 0532 0130 00", out: "DE89370400440532013000"},
+		{in: " de89-3704-0044-0532-0130-00\n", out: "DE89370400440532013000"},
+		{in: "IBAN: DE89 3704 0044 0532 0130 00", out: "DE89370400440532013000"},
+		{in: "iban:DE89370400440532013000", out: "DE89370400440532013000"},
+		{in: "GB82 WEST.1234.5698.7654.32", out: "GB82WEST12345698765432"},
+		{in: "DE89#3704", out: "DE89#3704"},
+		{in: "", out: ""},
+	} {
+		if out := Normalize(tc.in); out != tc.out {
+			t.Errorf("%q: got %q expected=%q\n", tc.in, out, tc.out)
+		}
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+	i, err := ParseLenient("iban de89 3704 0044 0532 0130 00")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if i.String() != "DE89370400440532013000" {
+		t.Errorf("got %s\n", i)
+	}
+	if _, err := ParseLenient("de88 3704 0044 0532 0130 00"); !errors.Is(err, ErrInvalidChecksum) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrInvalidChecksum)
+	}
+}
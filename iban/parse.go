@@ -0,0 +1,120 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reason is a machine-readable reason why a string is not a valid IBAN.
+type Reason string
+
+const (
+	// ReasonUnknownCountry means the country code is not in the IBAN registry.
+	ReasonUnknownCountry Reason = "unknownCountry"
+	// ReasonInvalidLength means the length does not match the country.
+	ReasonInvalidLength Reason = "invalidLength"
+	// ReasonIllegalCharacters means the IBAN contains characters other than A-Z and 0-9.
+	ReasonIllegalCharacters Reason = "illegalCharacters"
+	// ReasonInvalidBBAN means the BBAN does not match the structure of the country.
+	ReasonInvalidBBAN Reason = "invalidBBAN"
+	// ReasonInvalidChecksum means the mod-97 checksum failed.
+	ReasonInvalidChecksum Reason = "invalidChecksum"
+	// ReasonNationalCheckDigit means the national check digits in the BBAN are wrong.
+	ReasonNationalCheckDigit Reason = "nationalCheckDigit"
+)
+
+// Error is returned when a string cannot be parsed as an IBAN.
+// Use errors.Is with the Err* variables to test for a specific Reason.
+type Error struct {
+	Reason Reason
+	msg    string
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Is reports whether target is an *Error with the same Reason.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Reason == e.Reason
+}
+
+var (
+	// ErrUnknownCountry is returned for unknown country codes.
+	ErrUnknownCountry = &Error{Reason: ReasonUnknownCountry, msg: "unknown country code"}
+	// ErrInvalidLength is returned if the length does not match the country.
+	ErrInvalidLength = &Error{Reason: ReasonInvalidLength, msg: "invalid length"}
+	// ErrIllegalCharacters is returned if the IBAN contains illegal characters.
+	ErrIllegalCharacters = &Error{Reason: ReasonIllegalCharacters, msg: "illegal characters"}
+	// ErrInvalidBBAN is returned if the BBAN does not match the structure of the country.
+	ErrInvalidBBAN = &Error{Reason: ReasonInvalidBBAN, msg: "invalid BBAN"}
+	// ErrInvalidChecksum is returned if the mod-97 checksum failed.
+	ErrInvalidChecksum = &Error{Reason: ReasonInvalidChecksum, msg: "invalid checksum"}
+	// ErrNationalCheckDigit is returned if the national check digits in the BBAN are wrong.
+	ErrNationalCheckDigit = &Error{Reason: ReasonNationalCheckDigit, msg: "invalid national check digits"}
+)
+
+func newError(reason Reason, format string, a ...interface{}) *Error {
+	return &Error{Reason: reason, msg: fmt.Sprintf(format, a...)}
+}
+
+// ReasonOf returns the Reason of err or an empty Reason if err is not an *Error.
+func ReasonOf(err error) Reason {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Reason
+	}
+	return ""
+}
+
+// Parse parses an IBAN in its electronic format, e.g. "DE89370400440532013000".
+// The returned error is an *Error.
+func Parse(s string) (*IBAN, error) {
+	for i := 0; i < len(s); i++ {
+		if !inClass(classAlphaNum, s[i]) {
+			return nil, newError(ReasonIllegalCharacters, "illegal character %q at position %d", s[i], i)
+		}
+	}
+	if len(s) < 4 {
+		return nil, newError(ReasonInvalidLength, "IBAN %q is too short", s)
+	}
+	cc := CountryCode(s[:2])
+	b, ok := countries[cc]
+	if !ok {
+		return nil, newError(ReasonUnknownCountry, "unknown country code %q", string(cc))
+	}
+	if len(s) != b.length {
+		return nil, newError(ReasonInvalidLength, "IBAN must be %d characters for %s, got %d", b.length, string(cc), len(s))
+	}
+	cs, bban := s[2:4], s[4:]
+	if !matches("nn", cs) || cs == "00" || cs == "01" || cs == "99" {
+		return nil, newError(ReasonInvalidChecksum, "invalid check digits %q", cs)
+	}
+	if !matches(b.pattern, bban) {
+		return nil, newError(ReasonInvalidBBAN, "BBAN %q does not match the structure %s of %s", bban, b.format, string(cc))
+	}
+	if mod97(toNum(bban+s[:4])) != 1 {
+		return nil, newError(ReasonInvalidChecksum, "checksum of %q is invalid", s)
+	}
+	if n, ok := withNationalCheckDigits(cc, bban); !ok || n != bban {
+		return nil, newError(ReasonNationalCheckDigit, "national check digits of BBAN %q are invalid", bban)
+	}
+	bc, aNo := b.split(bban)
+	return &IBAN{
+		bc:  bc,
+		aNo: aNo,
+		cc:  cc,
+		cs:  cs,
+	}, nil
+}
+
+// mod97 returns the remainder of the decimal number s divided by 97.
+func mod97(s string) int {
+	r := 0
+	for i := 0; i < len(s); i++ {
+		r = (r*10 + int(s[i]-'0')) % 97
+	}
+	return r
+}
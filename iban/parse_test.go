@@ -0,0 +1,83 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		out IBAN
+		err error
+	}{
+		{
+			in:  "DE89370400440532013000",
+			out: IBAN{bc: "37040044", aNo: "0532013000", cc: CountryCodeDE, cs: "89"},
+		},
+		{
+			in:  "IT60X0542811101000000123456",
+			out: IBAN{bc: "0542811101", aNo: "X000000123456", cc: "IT", cs: "60"},
+		},
+		{
+			in:  "GB82WEST12345698765432",
+			out: IBAN{bc: "WEST123456", aNo: "98765432", cc: "GB", cs: "82"},
+		},
+		{
+			in:  "XX89370400440532013000",
+			err: ErrUnknownCountry,
+		},
+		{
+			in:  "DE8937040044053201300",
+			err: ErrInvalidLength,
+		},
+		{
+			in:  "DE89 3704 0044 0532 0130 00",
+			err: ErrIllegalCharacters,
+		},
+		{
+			in:  "DE8937040044053201300A",
+			err: ErrInvalidBBAN,
+		},
+		{
+			in:  "DE88370400440532013000",
+			err: ErrInvalidChecksum,
+		},
+		{
+			in:  "DE89370400440532013001",
+			err: ErrInvalidChecksum,
+		},
+	} {
+		out, err := Parse(tc.in)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s: got err=%v expected=%v\n", tc.in, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: got err=%q\n", tc.in, err.Error())
+			continue
+		}
+		if *out != tc.out {
+			t.Errorf("%s: got=%v expected=%v\n", tc.in, *out, tc.out)
+		}
+	}
+}
+
+func TestParseGenerated(t *testing.T) {
+	for _, cc := range CountryCodes() {
+		i, err := GenerateForCountry(cc)
+		if err != nil {
+			t.Fatalf("%s: got err=%q\n", cc, err.Error())
+		}
+		p, err := Parse(i.String())
+		if err != nil {
+			t.Errorf("%s: got err=%q\n", i.String(), err.Error())
+			continue
+		}
+		if *p != *i {
+			t.Errorf("got=%v expected=%v\n", *p, *i)
+		}
+	}
+}
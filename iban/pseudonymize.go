@@ -0,0 +1,171 @@
+package iban
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ErrEmptyKey is returned if a Pseudonymizer is created without a key.
+var ErrEmptyKey = errors.New("the key must not be empty")
+
+const (
+	// feistelRounds is the number of rounds of the Feistel network that permutes account numbers.
+	feistelRounds = 10
+	// maxWalk is the maximum number of permutations that are applied to find a pseudonym
+	// of the same kind as the IBAN.
+	maxWalk = 1000000
+)
+
+// Pseudonymizer replaces real IBANs with fake IBANs, e.g. to copy production data into a staging environment.
+// A pseudonym keeps the country and bank code of the IBAN, has a new account number and valid check digits.
+// The same IBAN and key always result in the same pseudonym, so that joins on pseudonymized data still work.
+// Without the key pseudonyms cannot be linked to the IBANs they replace.
+//
+// The account numbers of a bank are permuted with a Feistel network that is keyed with the HMAC of the key,
+// so that two IBANs never get the same pseudonym. The permutation is applied repeatedly (cycle walking)
+// until the account number passes the check method of the bank if the IBAN's does and fails it if the IBAN's fails it.
+type Pseudonymizer struct {
+	key    []byte
+	method func(cc CountryCode, bc string) string
+}
+
+// NewPseudonymizer returns a new Pseudonymizer that derives pseudonyms from the key.
+// The optional method returns the check method of the Deutsche Bundesbank for a bank,
+// which the account numbers of German pseudonyms then pass.
+func NewPseudonymizer(key []byte, method func(cc CountryCode, bc string) string) (*Pseudonymizer, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
+	return &Pseudonymizer{
+		key:    append([]byte(nil), key...),
+		method: method,
+	}, nil
+}
+
+// Pseudonymize returns the pseudonym of the IBAN.
+// Different IBANs always get different pseudonyms. A pseudonym is the IBAN itself
+// only as likely as a random account number of the bank is.
+// ErrUnsupportedMethod is returned for German banks whose check method is not implemented.
+func (p *Pseudonymizer) Pseudonymize(i *IBAN) (*IBAN, error) {
+	var method string
+	if p.method != nil && i.cc == CountryCodeDE {
+		method = p.method(i.cc, i.bc)
+	}
+	checked, err := checked(method)
+	if err != nil {
+		return nil, err
+	}
+	b := countries[i.cc]
+	bban := []byte(b.join(i.bc, i.aNo))
+	// Only the positions that are neither part of the bank code nor national check digits are permuted.
+	check := nationalCheckDigits[i.cc]
+	var pos []int
+	for k := range b.pattern {
+		if (k >= b.bankCode[0] && k < b.bankCode[1]) || (k >= check[0] && k < check[1]) {
+			continue
+		}
+		pos = append(pos, k)
+	}
+	// valid returns the BBAN with national check digits and reports whether they
+	// could be computed and the account number passes the check method.
+	valid := func(s string) (string, bool) {
+		bban, ok := withNationalCheckDigits(i.cc, s)
+		if !ok {
+			return s, false
+		}
+		if checked {
+			_, aNo := b.split(bban)
+			return bban, CheckBankAccountNo(method, i.bc, aNo) == nil
+		}
+		return bban, true
+	}
+	_, wasValid := valid(string(bban))
+
+	// The characters at the positions are the digits of a number with mixed radixes.
+	n, x := big.NewInt(1), new(big.Int)
+	for _, k := range pos {
+		chars := classChars(b.pattern[k])
+		n.Mul(n, big.NewInt(int64(len(chars))))
+		x.Mul(x, big.NewInt(int64(len(chars))))
+		x.Add(x, big.NewInt(int64(strings.IndexByte(chars, bban[k]))))
+	}
+	tweak := []byte(string(i.cc) + i.bc)
+	for w := 0; w < maxWalk; w++ {
+		x = p.permute(tweak, x, n)
+		y := new(big.Int).Set(x)
+		for k := len(pos) - 1; k >= 0; k-- {
+			chars := classChars(b.pattern[pos[k]])
+			r := new(big.Int)
+			y.DivMod(y, big.NewInt(int64(len(chars))), r)
+			bban[pos[k]] = chars[r.Int64()]
+		}
+		if s, ok := valid(string(bban)); ok == wasValid {
+			bc, aNo := b.split(s)
+			return IBAN{
+				bc:  bc,
+				aNo: aNo,
+				cc:  i.cc,
+			}.check()
+		}
+	}
+	return nil, fmt.Errorf("failed to find a pseudonym in %d permutations", maxWalk)
+}
+
+// PseudonymizeString returns the pseudonym of the IBAN s, which is normalized with Normalize.
+// The pseudonym is in the print format if s is, otherwise in the electronic format.
+func (p *Pseudonymizer) PseudonymizeString(s string) (string, error) {
+	i, err := ParseLenient(s)
+	if err != nil {
+		return "", err
+	}
+	ret, err := p.Pseudonymize(i)
+	if err != nil {
+		return "", err
+	}
+	if s == i.Print() {
+		return ret.Print(), nil
+	}
+	return ret.String(), nil
+}
+
+// permute returns the number in [0, n) that the keyed permutation for the tweak maps x in [0, n) to.
+// The balanced Feistel network permutes the numbers with the bit length of n-1
+// and is applied again to numbers that are not less than n.
+func (p *Pseudonymizer) permute(tweak []byte, x, n *big.Int) *big.Int {
+	bits := new(big.Int).Sub(n, big.NewInt(1)).BitLen()
+	if bits < 2 {
+		bits = 2
+	}
+	u, v := uint(bits/2), uint(bits-bits/2)
+	for {
+		l := new(big.Int).Rsh(x, v)
+		r := new(big.Int).Sub(x, new(big.Int).Lsh(l, v))
+		for round := 0; round < feistelRounds; round++ {
+			// The halves have u and v bits and swap their sizes every round.
+			m := u
+			if round%2 == 1 {
+				m = v
+			}
+			c := new(big.Int).Add(l, p.round(tweak, round, r))
+			c.Mod(c, new(big.Int).Lsh(big.NewInt(1), m))
+			l, r = r, c
+		}
+		x = l.Lsh(l, v).Add(l, r)
+		if x.Cmp(n) < 0 {
+			return x
+		}
+	}
+}
+
+// round returns the round function of the Feistel network, the HMAC of the tweak, the round and the half.
+func (p *Pseudonymizer) round(tweak []byte, round int, half *big.Int) *big.Int {
+	mac := hmac.New(sha256.New, p.key)
+	mac.Write(tweak)
+	mac.Write([]byte{byte(round)})
+	mac.Write(half.Bytes())
+	return new(big.Int).SetBytes(mac.Sum(nil))
+}
@@ -0,0 +1,125 @@
+package iban
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestPseudonymize(t *testing.T) {
+	p, err := NewPseudonymizer([]byte("secret"), func(cc CountryCode, bc string) string {
+		if bc == "37040044" {
+			return "13"
+		}
+		return ""
+	})
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	other, _ := NewPseudonymizer([]byte("other"), nil)
+	for _, in := range []string{
+		"DE89370400440532013000",
+		"GB82WEST12345698765432",
+		"NL91ABNA0417164300",
+		"FR1420041010050500013M02606",
+		"IT60X0542811101000000123456",
+		"BE68539007547034",
+		"LC55HEMM000100010012001200023015",
+	} {
+		i, err := Parse(in)
+		if err != nil {
+			t.Fatalf("%s: got err=%q\n", in, err.Error())
+		}
+		out, err := p.Pseudonymize(i)
+		if err != nil {
+			t.Errorf("%s: got err=%q\n", in, err.Error())
+			continue
+		}
+		if _, err := Parse(out.String()); err != nil {
+			t.Errorf("%s: got invalid pseudonym %s: %v\n", in, out, err)
+		}
+		if out.CountryCode() != i.CountryCode() || out.BankCode() != i.BankCode() {
+			t.Errorf("%s: got %s with a different country or bank code\n", in, out)
+		}
+		if out.String() == in {
+			t.Errorf("%s: got the IBAN itself\n", in)
+		}
+		if again, _ := p.Pseudonymize(i); again.String() != out.String() {
+			t.Errorf("%s: got %s and %s for the same key\n", in, out, again)
+		}
+		if o, _ := other.Pseudonymize(i); o.String() == out.String() {
+			t.Errorf("%s: got %s for different keys\n", in, out)
+		}
+	}
+	i, _ := Parse("DE89370400440532013000")
+	out, _ := p.Pseudonymize(i)
+	if err := CheckAccountNo("13", out.AccountNo()); err != nil {
+		t.Errorf("got err=%q for %s\n", err.Error(), out)
+	}
+}
+
+func TestPseudonymizeString(t *testing.T) {
+	p, _ := NewPseudonymizer([]byte("secret"), nil)
+	electronic, err := p.PseudonymizeString("DE89370400440532013000")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	printed, err := p.PseudonymizeString("DE89 3704 0044 0532 0130 00")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if i, _ := Parse(electronic); printed != i.Print() {
+		t.Errorf("got %q expected=%q\n", printed, i.Print())
+	}
+	if _, err := p.PseudonymizeString("DE88370400440532013000"); !errors.Is(err, ErrInvalidChecksum) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrInvalidChecksum)
+	}
+	if _, err := NewPseudonymizer(nil, nil); !errors.Is(err, ErrEmptyKey) {
+		t.Errorf("got err=%v expected=%v\n", err, ErrEmptyKey)
+	}
+}
+
+func TestPseudonymizeInjective(t *testing.T) {
+	p, _ := NewPseudonymizer([]byte("secret"), func(cc CountryCode, bc string) string {
+		return "13"
+	})
+	n := big.NewInt(1000)
+	seen := make(map[int64]bool)
+	for x := int64(0); x < n.Int64(); x++ {
+		y := p.permute([]byte("DE37040044"), big.NewInt(x), n)
+		if y.Cmp(n) >= 0 || seen[y.Int64()] {
+			t.Fatalf("%d: got %d twice or out of range\n", x, y)
+		}
+		seen[y.Int64()] = true
+	}
+
+	e, _ := NewEnumerator(CountryCodeDE, "37040044", "13")
+	pseudonyms := make(map[string]string)
+	for k := 0; k < 2000; k++ {
+		i, ok := e.Next()
+		if !ok {
+			t.Fatalf("got no IBAN after %d\n", k)
+		}
+		out, err := p.Pseudonymize(i)
+		if err != nil {
+			t.Fatalf("%s: got err=%q\n", i.String(), err.Error())
+		}
+		if in, ok := pseudonyms[out.String()]; ok {
+			t.Errorf("%s: got %s, which is the pseudonym of %s\n", i.String(), out.String(), in)
+		}
+		pseudonyms[out.String()] = i.String()
+		if err := CheckAccountNo("13", out.AccountNo()); err != nil {
+			t.Errorf("%s: got err=%q for %s\n", i.String(), err.Error(), out.String())
+		}
+	}
+
+	// Account numbers that fail the check method get pseudonyms that fail it, too.
+	i, _ := Parse("DE14370400441234567890")
+	out, err := p.Pseudonymize(i)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if err := CheckAccountNo("13", out.AccountNo()); !errors.Is(err, ErrAccountCheckDigit) {
+		t.Errorf("%s: got err=%v expected=%v\n", out.String(), err, ErrAccountCheckDigit)
+	}
+}
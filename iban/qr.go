@@ -0,0 +1,80 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// CountryCodeCH is the Swiss country code.
+	CountryCodeCH = "CH"
+	// CountryCodeLI is the Liechtenstein country code.
+	CountryCodeLI = "LI"
+
+	// qrIIDMin and qrIIDMax is the range of institution ids of QR-IBANs.
+	qrIIDMin = 30000
+	qrIIDMax = 31999
+
+	// qrReferenceLength is the length of a QR reference including its check digit.
+	qrReferenceLength = 27
+)
+
+// ErrInvalidQRReference is returned for invalid QR references.
+var ErrInvalidQRReference = errors.New("invalid QR reference")
+
+// GenerateSwiss generates an IBAN for Switzerland or Liechtenstein.
+// If qr is true, a QR-IBAN is generated, whose institution id (IID) is in the range 30000-31999,
+// otherwise the IID is outside of this range.
+func (g *Generator) GenerateSwiss(cc CountryCode, qr bool) (*IBAN, error) {
+	if cc != CountryCodeCH && cc != CountryCodeLI {
+		return nil, fmt.Errorf("QR-IBANs are not supported for %s", string(cc))
+	}
+	iid := qrIIDMin + g.intn(qrIIDMax-qrIIDMin+1)
+	if !qr {
+		// Pick from all five digit IIDs except the QR range.
+		iid = g.intn(100000 - (qrIIDMax - qrIIDMin + 1))
+		if iid >= qrIIDMin {
+			iid += qrIIDMax - qrIIDMin + 1
+		}
+	}
+	return g.GenerateFromBankCode(cc, fmt.Sprintf("%05d", iid))
+}
+
+// IsQRIBAN reports whether the IBAN is a Swiss or Liechtenstein QR-IBAN.
+func (i *IBAN) IsQRIBAN() bool {
+	if i.cc != CountryCodeCH && i.cc != CountryCodeLI {
+		return false
+	}
+	iid, err := strconv.Atoi(i.bc)
+	return err == nil && iid >= qrIIDMin && iid <= qrIIDMax
+}
+
+// GenerateQRReference generates a random QR reference with 27 digits
+// for Swiss QR-bills.
+func (g *Generator) GenerateQRReference() string {
+	ref := g.randomString(digitPattern(qrReferenceLength - 1))
+	return ref + strconv.Itoa(qrCheckDigit(ref))
+}
+
+// ValidateQRReference validates a QR reference.
+func ValidateQRReference(ref string) error {
+	if !matches(digitPattern(qrReferenceLength), ref) {
+		return fmt.Errorf("%w: must be %d digits", ErrInvalidQRReference, qrReferenceLength)
+	}
+	if qrCheckDigit(ref[:qrReferenceLength-1]) != int(ref[qrReferenceLength-1]-'0') {
+		return fmt.Errorf("%w: check digit is wrong", ErrInvalidQRReference)
+	}
+	return nil
+}
+
+var qrCarry = []int{0, 9, 4, 6, 8, 2, 7, 1, 3, 5}
+
+// qrCheckDigit computes the recursive modulus 10 check digit.
+func qrCheckDigit(s string) int {
+	c := 0
+	for i := 0; i < len(s); i++ {
+		c = qrCarry[(c+int(s[i]-'0'))%10]
+	}
+	return (10 - c) % 10
+}
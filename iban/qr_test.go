@@ -0,0 +1,42 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGenerateSwiss(t *testing.T) {
+	for _, cc := range []CountryCode{CountryCodeCH, CountryCodeLI} {
+		for _, qr := range []bool{true, false} {
+			for n := 0; n < 100; n++ {
+				i, err := GenerateSwiss(cc, qr)
+				if err != nil {
+					t.Fatalf("%s: got err=%q\n", cc, err.Error())
+				}
+				if i.IsQRIBAN() != qr {
+					t.Errorf("%s: got QR-IBAN=%t expected=%t\n", i.String(), i.IsQRIBAN(), qr)
+				}
+			}
+		}
+	}
+	if _, err := GenerateSwiss(CountryCodeDE, true); err == nil {
+		t.Errorf("expected an error for %s\n", CountryCodeDE)
+	}
+}
+
+func TestQRReference(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		err error
+	}{
+		{in: "210000000003139471430009017"},
+		{in: "210000000003139471430009018", err: ErrInvalidQRReference},
+		{in: "21000000000313947143000901", err: ErrInvalidQRReference},
+		{in: "2100000000031394714300090A7", err: ErrInvalidQRReference},
+		{in: GenerateQRReference()},
+	} {
+		if err := ValidateQRReference(tc.in); !errors.Is(err, tc.err) {
+			t.Errorf("%s: got err=%v expected=%v\n", tc.in, err, tc.err)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package iban
+
+import (
+	"strings"
+)
+
+// Redact masks every IBAN in the free text s like FormatMasked, whether it is valid or not,
+// e.g. "IBAN: DE89 3704 0044 0532 0130 00" becomes "IBAN: DE89 **** **** **** **30 00".
+// IBANs are found like with a Scanner, but regardless of their case and with all separators
+// that Normalize removes. To fail closed, IBAN-shaped tokens with a wrong length are masked, too.
+func Redact(s string) string {
+	b := []byte(s)
+	var sb strings.Builder
+	last := 0
+	for i := 0; i < len(b); i++ {
+		if i > 0 && isAlphaNum(b[i-1]) {
+			continue
+		}
+		n, _, ok := candidate(b[i:], true)
+		if !ok {
+			n = ibanShaped(b[i:])
+		}
+		if n == 0 {
+			continue
+		}
+		sb.WriteString(s[last:i])
+		sb.WriteString(maskRaw(s[i : i+n]))
+		last = i + n
+		i += n - 1
+	}
+	if last == 0 {
+		return s
+	}
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// ibanShaped returns the length of the IBAN-shaped token at the beginning of buf or 0.
+// A token starts with a country code in any case and two check digits,
+// followed by letters and digits in groups. Groups that are separated by whitespace
+// must have four characters like in the print format.
+// The token has between 12 and 34 characters without separators and at least six digits in the BBAN.
+func ibanShaped(buf []byte) int {
+	if len(buf) < 4 || !isAlphaNum(buf[0]) || !isAlphaNum(buf[1]) || !isDigit(buf[2]) || !isDigit(buf[3]) {
+		return 0
+	}
+	if _, ok := countries[CountryCode(strings.ToUpper(string(buf[:2])))]; !ok {
+		return 0
+	}
+	n, digits, group, end := 0, 0, 0, 0
+	for i := 0; i < len(buf); {
+		if isAlphaNum(buf[i]) {
+			if n >= 4 && isDigit(buf[i]) {
+				digits++
+			}
+			n++
+			group++
+			i++
+			end = i
+			continue
+		}
+		l, space := separator(buf[i:], true)
+		if l == 0 || i+l >= len(buf) || !isAlphaNum(buf[i+l]) || (space && group != 4) {
+			break
+		}
+		group = 0
+		i += l
+	}
+	if n < 12 || n > 34 || digits < 6 {
+		return 0
+	}
+	return end
+}
+
+// maskRaw masks all but the first and last four letters and digits of s like mask
+// and keeps all other characters.
+func maskRaw(s string) string {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if isAlphaNum(s[i]) {
+			n++
+		}
+	}
+	b := []byte(s)
+	for i, j := 0, 0; i < len(b); i++ {
+		if !isAlphaNum(b[i]) {
+			continue
+		}
+		if j >= 4 && j < n-4 {
+			b[i] = maskChar
+		}
+		j++
+	}
+	return string(b)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
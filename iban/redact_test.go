@@ -0,0 +1,57 @@
+package iban
+
+import (
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "DE89370400440532013000",
+			out: "DE89**************3000",
+		},
+		{
+			in:  "IBAN: DE89 3704 0044 0532 0130 00, BIC: COBADEFFXXX",
+			out: "IBAN: DE89 **** **** **** **30 00, BIC: COBADEFFXXX",
+		},
+		{
+			in:  `{"from":"gb82-west-1234-5698-7654-32","to":"DE88370400440532013000"}`,
+			out: `{"from":"gb82-****-****-****-**54-32","to":"DE88**************3000"}`,
+		},
+		{
+			in:  "mixed case De89370400440532013000",
+			out: "mixed case De89**************3000",
+		},
+		{
+			in:  "DE89.3704.0044.0532.0130.00 and DE89/3704/0044/0532/0130/00",
+			out: "DE89.****.****.****.**30.00 and DE89/****/****/****/**30/00",
+		},
+		{
+			in:  "too short DE8937040044053201300, too long DE893704004405320130001",
+			out: "too short DE89*************1300, too long DE89***************0001",
+		},
+		{
+			in:  "print DE89 3704 0044 0532 0130 0 is short",
+			out: "print DE89 **** **** **** *130 0 is short",
+		},
+		{
+			in:  "order 1234567890123456789012 of DE",
+			out: "order 1234567890123456789012 of DE",
+		},
+		{
+			in:  "we met at 12 o'clock in 2023 and DE12 times",
+			out: "we met at 12 o'clock in 2023 and DE12 times",
+		},
+		{
+			in:  "DE89 **** **** **** **30 00",
+			out: "DE89 **** **** **** **30 00",
+		},
+	} {
+		if out := Redact(tc.in); out != tc.out {
+			t.Errorf("%q: got %q expected=%q\n", tc.in, out, tc.out)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrNoIBAN is returned if the IBAN rule of a bank forbids deriving an IBAN for an account.
+	ErrNoIBAN = errors.New("no IBAN can be derived for the account")
+	// ErrUnsupportedRule is returned for IBAN rules that are not implemented.
+	ErrUnsupportedRule = errors.New("unsupported IBAN rule")
+	// ErrUnknownRule is returned if the IBAN rule of a bank is not known,
+	// e.g. because the bank data has no IBAN rule column.
+	ErrUnknownRule = errors.New("the IBAN rule of the bank is unknown")
+)
+
+// RuleStandard is the IBAN rule of the Deutsche Bundesbank for banks without special rules.
+const RuleStandard = "000000"
+
+// ibanRules contains the implemented IBAN rules of the Deutsche Bundesbank
+// by their four digit rule number. The last two digits of a rule are its version.
+// A rule returns the bank code and the ten digit account number to use for the IBAN.
+var ibanRules = map[string]func(bc, aNo string) (string, string, error){
+	// Standard rule.
+	"0000": func(bc, aNo string) (string, string, error) {
+		return bc, aNo, nil
+	},
+	// No IBAN may be derived for the bank.
+	"0001": func(bc, aNo string) (string, string, error) {
+		return "", "", ErrNoIBAN
+	},
+	// Deutsche Bank AG: no IBAN for accounts with an 86 or a 6 at the seventh position.
+	"0002": func(bc, aNo string) (string, string, error) {
+		if aNo[6] == '6' || aNo[6:8] == "86" {
+			return "", "", ErrNoIBAN
+		}
+		return bc, aNo, nil
+	},
+	// No IBAN for a single account.
+	"0003": func(bc, aNo string) (string, string, error) {
+		if aNo == "6161604670" {
+			return "", "", ErrNoIBAN
+		}
+		return bc, aNo, nil
+	},
+	// Landesbank Berlin: donation accounts are mapped to real accounts.
+	"0004": func(bc, aNo string) (string, string, error) {
+		if m, ok := rule0004[aNo]; ok {
+			return bc, m, nil
+		}
+		return bc, aNo, nil
+	},
+	// Berliner Volksbank: all bank codes are replaced by the main bank code.
+	"0008": func(bc, aNo string) (string, string, error) {
+		return "10090000", aNo, nil
+	},
+	// Deutsche Bank Privat- und Geschäftskunden: account numbers have seven digits and a two digit sub-account.
+	// Legacy account numbers without the sub-account get the main account 00.
+	"0020": func(bc, aNo string) (string, string, error) {
+		switch n := len(strings.TrimLeft(aNo, "0")); {
+		case n == 6 || n == 7:
+			return bc, aNo[2:] + "00", nil
+		case n == 8 || n == 9:
+			return bc, aNo, nil
+		}
+		return "", "", ErrNoIBAN
+	},
+	// Landesbank Baden-Württemberg: the regional bank codes are replaced by the bank code of the BW-Bank.
+	"0025": func(bc, aNo string) (string, string, error) {
+		return "60050101", aNo, nil
+	},
+}
+
+var rule0004 = map[string]string{
+	"0000000135": "0990021440",
+	"0000001111": "6600012020",
+	"0000001900": "0920019005",
+	"0000007878": "0780008006",
+	"0000008888": "0250030942",
+	"0000009595": "1653524703",
+	"0000097097": "0013044150",
+	"0000112233": "0630025819",
+	"0000336666": "6604058903",
+	"0000484848": "0920018963",
+}
+
+// ConvertLegacy converts a German bank code and account number to an IBAN
+// by applying the IBAN rule of the Deutsche Bundesbank for the bank.
+// An empty rule returns ErrUnknownRule, because applying RuleStandard
+// to a bank with a special rule would result in a wrong IBAN.
+func ConvertLegacy(bc, aNo, rule string) (*IBAN, error) {
+	if !matches(countries[CountryCodeDE].bankPattern(), bc) {
+		return nil, fmt.Errorf("bank code must be %d digits", 8)
+	}
+	if len(aNo) == 0 || len(aNo) > 10 || !matches(digitPattern(len(aNo)), aNo) {
+		return nil, ErrMalformedAccountNo
+	}
+	if rule == "" {
+		return nil, ErrUnknownRule
+	}
+	if len(rule) != 6 {
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedRule, rule)
+	}
+	f, ok := ibanRules[rule[:4]]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedRule, rule)
+	}
+	bc, aNo, err := f(bc, fmt.Sprintf("%010s", aNo))
+	if err != nil {
+		return nil, err
+	}
+	return IBAN{
+		bc:  bc,
+		aNo: aNo,
+		cc:  CountryCodeDE,
+	}.check()
+}
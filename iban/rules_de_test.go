@@ -0,0 +1,48 @@
+package iban
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConvertLegacy(t *testing.T) {
+	for _, tc := range []struct {
+		bc   string
+		aNo  string
+		rule string
+		out  string
+		err  error
+	}{
+		{bc: "37040044", aNo: "532013000", err: ErrUnknownRule},
+		{bc: "37040044", aNo: "532013000", rule: "000000", out: "DE89370400440532013000"},
+		{bc: "10020000", aNo: "135", rule: "000400", out: "DE14100200000990021440"},
+		{bc: "10090900", aNo: "532013000", rule: "000800", out: "DE23100900000532013000"},
+		{bc: "10070000", aNo: "1234586000", rule: "000200", err: ErrNoIBAN},
+		{bc: "10070000", aNo: "1234566000", rule: "000200", err: ErrNoIBAN},
+		{bc: "51010800", aNo: "6161604670", rule: "000300", err: ErrNoIBAN},
+		{bc: "10000000", aNo: "1", rule: "000100", err: ErrNoIBAN},
+		{bc: "10070024", aNo: "1234567", rule: "002000", out: "DE10100700240123456700"},
+		{bc: "10070024", aNo: "123456", rule: "002000", out: "DE06100700240012345600"},
+		{bc: "10070024", aNo: "12345601", rule: "002000", out: "DE76100700240012345601"},
+		{bc: "10070024", aNo: "12345", rule: "002000", err: ErrNoIBAN},
+		{bc: "10070024", aNo: "1234567890", rule: "002000", err: ErrNoIBAN},
+		{bc: "66050000", aNo: "532013000", rule: "002500", out: "DE78600501010532013000"},
+		{bc: "10000000", aNo: "1", rule: "999900", err: ErrUnsupportedRule},
+		{bc: "10000000", aNo: "12345678901", err: ErrMalformedAccountNo},
+	} {
+		out, err := ConvertLegacy(tc.bc, tc.aNo, tc.rule)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("%s %s: got err=%v expected=%v\n", tc.bc, tc.aNo, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %s: got err=%q\n", tc.bc, tc.aNo, err.Error())
+			continue
+		}
+		if out.String() != tc.out {
+			t.Errorf("%s %s: got=%q expected=%q\n", tc.bc, tc.aNo, out.String(), tc.out)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package iban
+
+import (
+	"bufio"
+	"io"
+)
+
+// Match is a candidate for an IBAN found by a Scanner.
+type Match struct {
+	// Offset is the byte offset of the candidate in the input.
+	Offset int64
+	// Raw is the candidate as it was written in the input, including separators.
+	Raw string
+	// IBAN is the candidate in the electronic format.
+	IBAN  string
+	Valid bool
+	// Err is the reason why an invalid candidate failed validation.
+	Err error
+}
+
+const (
+	// maxSeparator is the maximum number of bytes that can separate two characters of a candidate,
+	// which allows for e.g. " - " or "\r\n" with indentation.
+	maxSeparator = 4
+	// maxCandidate is the maximum number of bytes of a candidate including separators.
+	maxCandidate = 34 + 33*maxSeparator
+)
+
+// Scanner finds IBANs in free text, e.g. in emails or chat logs.
+// IBANs can be written in upper or lower case and with spaces, dashes or line breaks between their characters.
+// A candidate must start and end at a word boundary and match the length and structure of its country.
+// Candidates that fail the checksum or national checks are reported as invalid.
+type Scanner struct {
+	r      *bufio.Reader
+	offset int64
+	// boundary reports whether the last byte was not a letter or a digit.
+	boundary bool
+	match    Match
+	err      error
+}
+
+// NewScanner returns a new Scanner that reads from r.
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{
+		r:        bufio.NewReaderSize(r, 4*maxCandidate),
+		boundary: true,
+	}
+}
+
+// Scan advances the Scanner to the next candidate, which is then available through Match.
+// It returns false at the end of the input or on an error.
+func (s *Scanner) Scan() bool {
+	for {
+		buf, err := s.r.Peek(maxCandidate)
+		if len(buf) == 0 {
+			if err != io.EOF {
+				s.err = err
+			}
+			return false
+		}
+		if err != nil && err != io.EOF {
+			s.err = err
+			return false
+		}
+		if s.boundary {
+			if n, iban, ok := candidate(buf, false); ok {
+				s.match = Match{
+					Offset: s.offset,
+					Raw:    string(buf[:n]),
+					IBAN:   iban,
+				}
+				if _, err := Parse(iban); err != nil {
+					s.match.Err = err
+				} else {
+					s.match.Valid = true
+				}
+				s.r.Discard(n)
+				s.offset += int64(n)
+				s.boundary = false
+				return true
+			}
+		}
+		s.boundary = !isAlphaNum(buf[0])
+		s.r.Discard(1)
+		s.offset++
+	}
+}
+
+// Match returns the candidate found by the last call to Scan.
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// candidate returns the length of the candidate at the beginning of buf and the candidate
+// in the electronic format.
+// Lenient candidates can mix upper and lower case and use all separators that Normalize removes.
+func candidate(buf []byte, lenient bool) (int, string, bool) {
+	if len(buf) < 2 {
+		return 0, "", false
+	}
+	// All letters of a candidate must have the same case as the country code.
+	lower := buf[0] >= 'a' && buf[0] <= 'z'
+	toUpper := func(c byte) (byte, bool) {
+		switch {
+		case c >= '0' && c <= '9':
+			return c, true
+		case (lenient || !lower) && c >= 'A' && c <= 'Z':
+			return c, true
+		case (lenient || lower) && c >= 'a' && c <= 'z':
+			return c - 'a' + 'A', true
+		}
+		return 0, false
+	}
+	ret := make([]byte, 0, 34)
+	var pattern string
+	i := 0
+	for {
+		if len(ret) == 2 {
+			b, ok := countries[CountryCode(ret)]
+			if !ok {
+				return 0, "", false
+			}
+			pattern = "aann" + b.pattern
+		}
+		if len(ret) > 2 && len(ret) == len(pattern) {
+			// The candidate must end at a word boundary.
+			if i < len(buf) && isAlphaNum(buf[i]) {
+				return 0, "", false
+			}
+			return i, string(ret), true
+		}
+		if len(ret) > 0 {
+			n, _ := separator(buf[i:], lenient)
+			i += n
+		}
+		if i >= len(buf) {
+			return 0, "", false
+		}
+		c, ok := toUpper(buf[i])
+		if !ok {
+			return 0, "", false
+		}
+		class := byte(classLetter)
+		if len(ret) >= 2 {
+			class = pattern[len(ret)]
+		}
+		if !inClass(class, c) {
+			return 0, "", false
+		}
+		ret = append(ret, c)
+		i++
+	}
+}
+
+// separator returns the length of the separators at the beginning of buf
+// and whether they contain whitespace.
+// Lenient separators also include all separators that Normalize removes.
+func separator(buf []byte, lenient bool) (int, bool) {
+	i, space := 0, false
+	for i < len(buf) && i < maxSeparator {
+		switch {
+		case buf[i] == ' ' || buf[i] == '\t' || buf[i] == '\n' || buf[i] == '\r':
+			i++
+			space = true
+		case buf[i] == '-' || (lenient && isSeparator(rune(buf[i]))):
+			i++
+		// A non-breaking space in UTF-8.
+		case buf[i] == 0xc2 && i+1 < len(buf) && buf[i+1] == 0xa0:
+			i += 2
+			space = true
+		default:
+			return i, space
+		}
+	}
+	return i, space
+}
+
+func isAlphaNum(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z')
+}
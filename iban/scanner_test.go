@@ -0,0 +1,98 @@
+package iban
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   string
+		exp  []Match
+	}{
+		{
+			name: "electronic",
+			in:   "Please pay to DE89370400440532013000 until Friday.",
+			exp:  []Match{{Offset: 14, IBAN: "DE89370400440532013000", Valid: true}},
+		},
+		{
+			name: "print with prefix",
+			in:   "IBAN: DE89 3704 0044 0532 0130 00\nBIC: COBADEFFXXX",
+			exp:  []Match{{Offset: 6, IBAN: "DE89370400440532013000", Valid: true}},
+		},
+		{
+			name: "dashes and line breaks",
+			in:   "gb82-west-1234\r\n  5698-7654-32",
+			exp:  []Match{{Offset: 0, IBAN: "GB82WEST12345698765432", Valid: true}},
+		},
+		{
+			name: "non-breaking spaces",
+			in:   "NL91\u00a0ABNA\u00a00417\u00a01643\u00a000",
+			exp:  []Match{{Offset: 0, IBAN: "NL91ABNA0417164300", Valid: true}},
+		},
+		{
+			name: "invalid checksum",
+			in:   "DE88370400440532013000, NL91ABNA0417164300",
+			exp: []Match{
+				{Offset: 0, IBAN: "DE88370400440532013000"},
+				{Offset: 24, IBAN: "NL91ABNA0417164300", Valid: true},
+			},
+		},
+		{
+			name: "not at a word boundary",
+			in:   "XDE89370400440532013000 DE893704004405320130001",
+		},
+		{
+			name: "mixed case",
+			in:   "De89370400440532013000 DE89370400440532013000",
+			exp:  []Match{{Offset: 23, IBAN: "DE89370400440532013000", Valid: true}},
+		},
+		{
+			name: "too short",
+			in:   "DE89 3704 0044 0532 0130 call me at 0176",
+		},
+		{
+			name: "prose",
+			in:   "We met at 12 o'clock in DE and paid 1234 EUR.",
+		},
+	} {
+		s := NewScanner(strings.NewReader(tc.in))
+		var got []Match
+		for s.Scan() {
+			got = append(got, s.Match())
+		}
+		if err := s.Err(); err != nil {
+			t.Errorf("%s: got err=%q\n", tc.name, err.Error())
+		}
+		if len(got) != len(tc.exp) {
+			t.Errorf("%s: got %d matches expected=%d\n", tc.name, len(got), len(tc.exp))
+			continue
+		}
+		for i := range got {
+			if got[i].Offset != tc.exp[i].Offset || got[i].IBAN != tc.exp[i].IBAN || got[i].Valid != tc.exp[i].Valid {
+				t.Errorf("%s: got %+v expected=%+v\n", tc.name, got[i], tc.exp[i])
+			}
+			if !got[i].Valid && got[i].Err == nil {
+				t.Errorf("%s: expected an error for %s\n", tc.name, got[i].IBAN)
+			}
+			if got[i].Raw != tc.in[got[i].Offset:got[i].Offset+int64(len(got[i].Raw))] {
+				t.Errorf("%s: got raw %q\n", tc.name, got[i].Raw)
+			}
+		}
+	}
+}
+
+func TestScannerLongInput(t *testing.T) {
+	in := strings.Repeat("lorem ipsum ", 1000) + "DE89370400440532013000"
+	s := NewScanner(strings.NewReader(in))
+	if !s.Scan() {
+		t.Fatalf("expected a match\n")
+	}
+	if m := s.Match(); m.Offset != 12000 || !m.Valid {
+		t.Errorf("got %+v\n", m)
+	}
+	if s.Scan() {
+		t.Errorf("expected no more matches\n")
+	}
+}
@@ -0,0 +1,68 @@
+package iban
+
+import (
+	"sort"
+)
+
+// Candidate is a valid IBAN that might have been intended instead of a mistyped one.
+type Candidate struct {
+	IBAN *IBAN
+	// Kind is the mistake that the candidate corrects,
+	// either TypoSubstitution or TypoTransposition.
+	Kind TypoKind
+	// Position is the index of the first corrected character.
+	Position int
+	// KnownBank reports whether the bank code of the candidate is known.
+	KnownBank bool
+}
+
+// Suggest returns the valid IBANs that differ from s by a single substituted character
+// or a single transposition of adjacent characters.
+// The country code of s is assumed to be correct.
+func Suggest(s string) []Candidate {
+	return SuggestWithBanks(s, nil)
+}
+
+// SuggestWithBanks returns the candidates like Suggest, but ranks candidates with a known bank code first.
+func SuggestWithBanks(s string, known func(cc CountryCode, bc string) bool) []Candidate {
+	if len(s) < 4 {
+		return nil
+	}
+	cc := CountryCode(s[:2])
+	b, ok := countries[cc]
+	if !ok || len(s) != b.length {
+		return nil
+	}
+	p := "nn" + b.pattern
+	seen := make(map[string]struct{})
+	var ret []Candidate
+	add := func(k TypoKind, pos int, c string) {
+		if _, ok := seen[c]; ok || c == s {
+			return
+		}
+		i, err := Parse(c)
+		if err != nil {
+			return
+		}
+		seen[c] = struct{}{}
+		ret = append(ret, Candidate{
+			IBAN:      i,
+			Kind:      k,
+			Position:  pos,
+			KnownBank: known != nil && known(cc, i.BankCode()),
+		})
+	}
+	// Transpositions are ranked before substitutions, because they keep all characters.
+	for j := 2; j < len(s)-1; j++ {
+		add(TypoTransposition, j, s[:j]+s[j+1:j+2]+s[j:j+1]+s[j+2:])
+	}
+	for j := 2; j < len(s); j++ {
+		for _, c := range []byte(classChars(p[j-2])) {
+			add(TypoSubstitution, j, s[:j]+string(c)+s[j+1:])
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].KnownBank && !ret[j].KnownBank
+	})
+	return ret
+}
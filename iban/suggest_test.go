@@ -0,0 +1,56 @@
+package iban
+
+import (
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		exp string
+	}{
+		// Substituted digit.
+		{in: "DE89370400440532013001", exp: "DE89370400440532013000"},
+		// Transposed digits.
+		{in: "DE89370400440523013000", exp: "DE89370400440532013000"},
+		// Transposed check digits.
+		{in: "DE98370400440532013000", exp: "DE89370400440532013000"},
+		{in: "GB82WEST12345698765423", exp: "GB82WEST12345698765432"},
+	} {
+		found := false
+		for _, c := range Suggest(tc.in) {
+			if _, err := Parse(c.IBAN.String()); err != nil {
+				t.Errorf("%s: got invalid candidate %s\n", tc.in, c.IBAN.String())
+			}
+			if c.IBAN.String() == tc.exp {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%s: expected candidate %s in %v\n", tc.in, tc.exp, Suggest(tc.in))
+		}
+	}
+	for _, in := range []string{"DE8937040044053201300", "XX89370400440532013000", ""} {
+		if cs := Suggest(in); len(cs) != 0 {
+			t.Errorf("%s: got %d candidates expected none\n", in, len(cs))
+		}
+	}
+}
+
+func TestSuggestWithBanks(t *testing.T) {
+	in := "DE89370400440532013001"
+	cs := SuggestWithBanks(in, func(cc CountryCode, bc string) bool {
+		return cc == CountryCodeDE && bc == "37040044"
+	})
+	if len(cs) < 2 {
+		t.Fatalf("%s: got %d candidates expected at least 2\n", in, len(cs))
+	}
+	if !cs[0].KnownBank || cs[0].IBAN.BankCode() != "37040044" {
+		t.Errorf("%s: expected a candidate with a known bank first, got %s\n", in, cs[0].IBAN.String())
+	}
+	for _, c := range cs[1:] {
+		if c.KnownBank && !cs[0].KnownBank {
+			t.Errorf("%s: candidates are not ranked\n", in)
+		}
+	}
+}
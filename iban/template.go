@@ -0,0 +1,236 @@
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrMalformedTemplate is returned for templates that do not fit the IBAN structure of their country.
+	ErrMalformedTemplate = errors.New("malformed template")
+	// ErrNoCompletion is returned if no valid IBAN matches a template.
+	ErrNoCompletion = errors.New("no valid IBAN matches the template")
+	// ErrSearchLimit is returned if no valid IBAN was found for a template within the search limit,
+	// although valid IBANs can match it.
+	ErrSearchLimit = errors.New("search limit reached before a valid IBAN matched the template")
+)
+
+// wildcard matches every character that is allowed at its position.
+const wildcard = '*'
+
+// template is a parsed IBAN template.
+type template struct {
+	cc CountryCode
+	// pattern contains the character class of every position including the check digits.
+	pattern string
+	// chars contains the fixed characters and wildcards.
+	chars []byte
+	// ranges contains the numeric ranges of the template.
+	ranges []numRange
+}
+
+// numRange is a range of numbers at a position of a template.
+type numRange struct {
+	pos, width int
+	lo, hi     int
+}
+
+// parseTemplate parses a template like "DE** 3704 0044 99** ****" or "DE** 3704 0044 [0100000000-0199999999]".
+// Spaces are ignored, * matches any allowed character and [lo-hi] matches numbers from lo to hi
+// with the number of digits of lo. Templates that are shorter than the IBAN are padded with wildcards.
+func parseTemplate(s string) (*template, error) {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	if len(s) < 2 {
+		return nil, fmt.Errorf("%w: missing country code", ErrMalformedTemplate)
+	}
+	t := &template{cc: CountryCode(s[:2])}
+	b, ok := countries[t.cc]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownCountry, s[:2])
+	}
+	t.pattern = "aann" + b.pattern
+	for i := 0; i < len(s); i++ {
+		if s[i] != '[' {
+			t.chars = append(t.chars, s[i])
+			continue
+		}
+		end := strings.IndexByte(s[i:], ']')
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unterminated range at position %d", ErrMalformedTemplate, i)
+		}
+		parts := strings.Split(s[i+1:i+end], "-")
+		if len(parts) != 2 || len(parts[0]) != len(parts[1]) || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("%w: range %q must be two numbers of equal length", ErrMalformedTemplate, s[i:i+end+1])
+		}
+		p := digitPattern(len(parts[0]))
+		if !matches(p, parts[0]) || !matches(p, parts[1]) {
+			return nil, fmt.Errorf("%w: invalid range %q", ErrMalformedTemplate, s[i:i+end+1])
+		}
+		lo, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid range %q: %v", ErrMalformedTemplate, s[i:i+end+1], err)
+		}
+		hi, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid range %q: %v", ErrMalformedTemplate, s[i:i+end+1], err)
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("%w: range %q ends before it starts", ErrMalformedTemplate, s[i:i+end+1])
+		}
+		t.ranges = append(t.ranges, numRange{pos: len(t.chars), width: len(parts[0]), lo: lo, hi: hi})
+		for j := 0; j < len(parts[0]); j++ {
+			t.chars = append(t.chars, wildcard)
+		}
+		i += end
+	}
+	// Shorter templates are padded with wildcards.
+	for len(t.chars) < b.length {
+		t.chars = append(t.chars, wildcard)
+	}
+	if len(t.chars) != b.length {
+		return nil, fmt.Errorf("%w: IBAN must be %d characters for %s, got %d", ErrMalformedTemplate, b.length, string(t.cc), len(t.chars))
+	}
+	for i, c := range t.chars {
+		if c != wildcard && !inClass(t.pattern[i], c) {
+			return nil, fmt.Errorf("%w: illegal character %q at position %d", ErrMalformedTemplate, c, i)
+		}
+	}
+	for _, r := range t.ranges {
+		for i := r.pos; i < r.pos+r.width; i++ {
+			if !inClass(t.pattern[i], '0') {
+				return nil, fmt.Errorf("%w: range at position %d covers letters", ErrMalformedTemplate, r.pos)
+			}
+		}
+	}
+	return t, nil
+}
+
+// inRange returns the range that covers position i.
+func (t *template) inRange(i int) (numRange, bool) {
+	for _, r := range t.ranges {
+		if i >= r.pos && i < r.pos+r.width {
+			return r, true
+		}
+	}
+	return numRange{}, false
+}
+
+// completions returns the number of strings that match the template.
+func (t *template) completions() *big.Int {
+	ret := big.NewInt(1)
+	for i, c := range t.chars {
+		if _, ok := t.inRange(i); c == wildcard && !ok {
+			ret.Mul(ret, big.NewInt(int64(len(classChars(t.pattern[i])))))
+		}
+	}
+	for _, r := range t.ranges {
+		ret.Mul(ret, big.NewInt(int64(r.hi-r.lo+1)))
+	}
+	return ret
+}
+
+// complete fills the template. pick returns the index of the character or number to use
+// out of n possible ones.
+func (t *template) complete(pick func(n int) int) string {
+	s := make([]byte, len(t.chars))
+	copy(s, t.chars)
+	for i, c := range t.chars {
+		if _, ok := t.inRange(i); c == wildcard && !ok {
+			chars := classChars(t.pattern[i])
+			s[i] = chars[pick(len(chars))]
+		}
+	}
+	for _, r := range t.ranges {
+		copy(s[r.pos:], fmt.Sprintf("%0*d", r.width, r.lo+pick(r.hi-r.lo+1)))
+	}
+	return string(s)
+}
+
+// matches reports whether s matches the template.
+func (t *template) matches(s string) bool {
+	for i, c := range t.chars {
+		if _, ok := t.inRange(i); c != wildcard && !ok && s[i] != c {
+			return false
+		}
+	}
+	for _, r := range t.ranges {
+		if n, err := strconv.Atoi(s[r.pos : r.pos+r.width]); err != nil || n < r.lo || n > r.hi {
+			return false
+		}
+	}
+	return true
+}
+
+// valid returns the IBAN for a completed template with national check digits and check digits,
+// if the IBAN still matches the template and its account number passes the check method.
+func (t *template) valid(s, method string) (*IBAN, bool) {
+	bban, ok := withNationalCheckDigits(t.cc, s[4:])
+	if !ok {
+		return nil, false
+	}
+	s = withCheckDigits(t.cc, bban)
+	if !t.matches(s) {
+		return nil, false
+	}
+	i, err := Parse(s)
+	if err != nil {
+		return nil, false
+	}
+	if ok, _ := checked(method); ok && t.cc == CountryCodeDE && CheckBankAccountNo(method, i.bc, i.aNo) != nil {
+		return nil, false
+	}
+	return i, true
+}
+
+// GenerateFromTemplate generates an IBAN that matches the template, e.g. "DE** 3704 0044 99** ****"
+// or "DE** 3704 0044 [0100000000-0199999999]". Spaces are ignored, * matches any allowed character
+// and [lo-hi] matches the numbers from lo to hi with the number of digits of lo.
+// Templates that are shorter than the IBAN are padded with wildcards.
+// Check digits and national check digits are computed and must be wildcards or match the computed ones.
+// The method is the check method of a German bank and can be empty.
+// Templates with up to 10000 completions are searched exhaustively and ErrNoCompletion is returned
+// if no valid IBAN matches them. Larger templates are completed at random and ErrSearchLimit is returned
+// if none of 10000 completions is valid. ErrUnsupportedMethod is returned if the method is not implemented.
+//
+// It is separate from GenerateFromBankCode, whose bank code is validated strictly,
+// because a template constrains the whole IBAN: a template of a country code, wildcard check digits
+// and a bank code is completed like GenerateFromBankCode.
+func (g *Generator) GenerateFromTemplate(tpl, method string) (*IBAN, error) {
+	t, err := parseTemplate(tpl)
+	if err != nil {
+		return nil, err
+	}
+	if t.cc == CountryCodeDE {
+		if _, err := checked(method); err != nil {
+			return nil, err
+		}
+	}
+	if n := t.completions(); n.Cmp(big.NewInt(maxAttempts)) <= 0 {
+		// Small templates are completed exhaustively, so that a missing completion is certain.
+		var valid []*IBAN
+		for k := 0; k < int(n.Int64()); k++ {
+			rest := k
+			s := t.complete(func(n int) int {
+				i := rest % n
+				rest /= n
+				return i
+			})
+			if i, ok := t.valid(s, method); ok {
+				valid = append(valid, i)
+			}
+		}
+		if len(valid) == 0 {
+			return nil, ErrNoCompletion
+		}
+		return valid[g.intn(len(valid))], nil
+	}
+	for k := 0; k < maxAttempts; k++ {
+		if i, ok := t.valid(t.complete(g.intn), method); ok {
+			return i, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no match found in %d attempts", ErrSearchLimit, maxAttempts)
+}
@@ -0,0 +1,60 @@
+package iban
+
+import (
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestGenerateFromTemplate(t *testing.T) {
+	g := NewGenerator(rand.NewSource(1))
+	for _, tc := range []struct {
+		tpl    string
+		method string
+		prefix string
+		suffix string
+		err    error
+	}{
+		{tpl: "DE** 3704 0044 99** ****", prefix: "3704004499"},
+		{tpl: "DE** 3704 0044 **** **00 01", suffix: "0001"},
+		{tpl: "DE** 3704 0044 [0100000000-0100000099]", prefix: "3704004401000000"},
+		{tpl: "DE** 3704 0044 0532 0130 0*", prefix: "37040044053201300"},
+		{tpl: "DE** 3704 0044 **** **** **", method: "13"},
+		{tpl: "FR** 3000 6000 01** **** **** ***"},
+		{tpl: "GB** WEST 1234 5698 7654 32", prefix: "WEST12345698765432"},
+		// The check digits of the account are fixed and wrong.
+		{tpl: "DE00 3704 0044 0532 0130 00", err: ErrNoCompletion},
+		{tpl: "DE88 3704 0044 0532 0130 0*", err: ErrNoCompletion},
+		// Large templates are not searched exhaustively.
+		{tpl: "DE00 3704 0044 **** **** **", err: ErrSearchLimit},
+		{tpl: "NO** 8601 1117 94*", prefix: "8601111794"},
+		{tpl: "DE** 3704 0044 99** **** **** *", err: ErrMalformedTemplate},
+		{tpl: "DE** 3704 0044 [01-0]** ****", err: ErrMalformedTemplate},
+		{tpl: "DE** 3704 0044 [01-02 ** ****", err: ErrMalformedTemplate},
+		{tpl: "DE** 3704 0044 [0200000000-0100000000]", err: ErrMalformedTemplate},
+		// The range does not fit into an int.
+		{tpl: "LC** HEMM [00000000000000000000-99999999999999999999] ****", err: ErrMalformedTemplate},
+		{tpl: "DE** 3704 0044 99A* ****", err: ErrMalformedTemplate},
+		{tpl: "XX** 3704 0044 99** ****", err: ErrUnknownCountry},
+	} {
+		i, err := g.GenerateFromTemplate(tc.tpl, tc.method)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("%s: got err=%v expected=%v\n", tc.tpl, err, tc.err)
+		}
+		if err != nil {
+			continue
+		}
+		if _, err := Parse(i.String()); err != nil {
+			t.Errorf("%s: got invalid IBAN %s: %v\n", tc.tpl, i.String(), err)
+		}
+		if !strings.HasPrefix(i.BBAN(), tc.prefix) || !strings.HasSuffix(i.BBAN(), tc.suffix) {
+			t.Errorf("%s: got %s\n", tc.tpl, i.String())
+		}
+		if tc.method != "" {
+			if err := CheckAccountNo(tc.method, i.AccountNo()); err != nil {
+				t.Errorf("%s: got err=%q\n", i.String(), err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package iban
+
+import (
+	"strings"
+)
+
+// TypoKind is a kind of typing mistake.
+type TypoKind string
+
+const (
+	// TypoSubstitution replaces a digit with another digit.
+	TypoSubstitution TypoKind = "substitution"
+	// TypoTransposition swaps two adjacent characters.
+	TypoTransposition TypoKind = "transposition"
+	// TypoDeletion drops a character.
+	TypoDeletion TypoKind = "deletion"
+	// TypoDuplication types a character twice.
+	TypoDuplication TypoKind = "duplication"
+	// TypoLookalike confuses O with 0 or I with 1.
+	TypoLookalike TypoKind = "lookalike"
+	// TypoLowercase types the IBAN in lowercase letters.
+	TypoLowercase TypoKind = "lowercase"
+)
+
+// Typo is a variant of an IBAN with a typing mistake.
+type Typo struct {
+	Kind TypoKind
+	// Position is the index of the first changed character in the IBAN.
+	Position int
+	Variant  string
+	// Caught reports whether the mod-97 checksum detects the mistake.
+	// Lowercase letters are not caught, because the checksum ignores the case.
+	Caught bool
+}
+
+var lookalikes = map[byte]byte{
+	'0': 'O',
+	'O': '0',
+	'1': 'I',
+	'I': '1',
+}
+
+// Typos returns all variants of the IBAN with a single typing mistake in a deterministic order.
+// Variants that are equal to the IBAN or to a previous variant are left out.
+func Typos(i *IBAN) []Typo {
+	s := i.String()
+	seen := map[string]struct{}{s: {}}
+	var ret []Typo
+	add := func(k TypoKind, pos int, v string) {
+		if _, ok := seen[v]; ok {
+			return
+		}
+		seen[v] = struct{}{}
+		ret = append(ret, Typo{Kind: k, Position: pos, Variant: v, Caught: !checksumValid(v)})
+	}
+	for j := 2; j < len(s); j++ {
+		if !inClass(classDigit, s[j]) {
+			continue
+		}
+		for _, d := range []byte(digits) {
+			add(TypoSubstitution, j, s[:j]+string(d)+s[j+1:])
+		}
+	}
+	for j := 0; j < len(s)-1; j++ {
+		add(TypoTransposition, j, s[:j]+s[j+1:j+2]+s[j:j+1]+s[j+2:])
+	}
+	for j := 0; j < len(s); j++ {
+		add(TypoDeletion, j, s[:j]+s[j+1:])
+	}
+	for j := 0; j < len(s); j++ {
+		add(TypoDuplication, j, s[:j+1]+s[j:])
+	}
+	for j := 0; j < len(s); j++ {
+		if c, ok := lookalikes[s[j]]; ok {
+			add(TypoLookalike, j, s[:j]+string(c)+s[j+1:])
+		}
+	}
+	add(TypoLowercase, 0, strings.ToLower(s))
+	return ret
+}
+
+// checksumValid reports whether the mod-97 checksum of s is valid regardless of its case and length.
+func checksumValid(s string) bool {
+	s = strings.ToUpper(s)
+	if len(s) < 4 {
+		return false
+	}
+	for j := 0; j < len(s); j++ {
+		if !inClass(classAlphaNum, s[j]) {
+			return false
+		}
+	}
+	return mod97(toNum(s[4:]+s[:4])) == 1
+}
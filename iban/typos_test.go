@@ -0,0 +1,59 @@
+package iban
+
+import (
+	"testing"
+)
+
+func TestTypos(t *testing.T) {
+	i, err := Parse("DE89370400440532013000")
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	kinds := make(map[TypoKind]int)
+	for _, ty := range Typos(i) {
+		kinds[ty.Kind]++
+		if ty.Variant == i.String() {
+			t.Errorf("%s: got the original IBAN as variant\n", ty.Kind)
+		}
+		// mod-97 detects all single digit substitutions.
+		if ty.Kind == TypoSubstitution && !ty.Caught {
+			t.Errorf("%s %s: expected the substitution to be caught\n", ty.Kind, ty.Variant)
+		}
+		if ty.Kind == TypoLowercase && (ty.Caught || ty.Variant != "de89370400440532013000") {
+			t.Errorf("%s %s: got caught=%t\n", ty.Kind, ty.Variant, ty.Caught)
+		}
+	}
+	for k, exp := range map[TypoKind]int{
+		// 20 digits with 9 substitutions each.
+		TypoSubstitution: 180,
+		TypoLookalike:    9,
+		TypoLowercase:    1,
+	} {
+		if kinds[k] != exp {
+			t.Errorf("%s: got %d variants expected=%d\n", k, kinds[k], exp)
+		}
+	}
+	for _, k := range []TypoKind{TypoTransposition, TypoDeletion, TypoDuplication} {
+		if kinds[k] == 0 {
+			t.Errorf("%s: got no variants\n", k)
+		}
+	}
+}
+
+func TestChecksumValid(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		exp bool
+	}{
+		{in: "DE89370400440532013000", exp: true},
+		{in: "de89370400440532013000", exp: true},
+		{in: "DE98370400440532013000"},
+		{in: "DE8937040044053201300"},
+		{in: "DE89-370400440532013000"},
+		{in: "DE8"},
+	} {
+		if got := checksumValid(tc.in); got != tc.exp {
+			t.Errorf("%s: got %t expected=%t\n", tc.in, got, tc.exp)
+		}
+	}
+}
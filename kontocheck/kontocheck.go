@@ -0,0 +1,76 @@
+// Package kontocheck checks the plausibility of German account numbers
+// with the check methods of the Deutsche Bundesbank.
+package kontocheck
+
+import (
+	"errors"
+
+	"github.com/leonnicolas/iban-gen/bic"
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+// Reason is a machine-readable reason why a check failed.
+type Reason string
+
+const (
+	// ReasonUnknownBankCode means the bank code is not in the bank data.
+	ReasonUnknownBankCode Reason = "unknownBankCode"
+	// ReasonMalformedAccountNo means the account number is not up to ten digits.
+	ReasonMalformedAccountNo Reason = "malformedAccountNo"
+	// ReasonUnsupportedMethod means the check method of the bank is not implemented.
+	ReasonUnsupportedMethod Reason = "unsupportedMethod"
+	// ReasonCheckDigit means the account number failed the check method.
+	ReasonCheckDigit Reason = "checkDigit"
+)
+
+// Result is the result of a plausibility check.
+type Result struct {
+	BankCode  string
+	AccountNo string
+	// Method is the check method that was applied.
+	Method string
+	Valid  bool
+	// Reason is empty if the account number is valid.
+	Reason Reason
+	// Err describes why the check failed.
+	Err error
+}
+
+// Checker checks account numbers with the check methods of the banks in a bic.BankRepo.
+type Checker struct {
+	bankRepo *bic.BankRepo
+}
+
+// NewChecker returns a new Checker.
+func NewChecker(bankRepo *bic.BankRepo) *Checker {
+	return &Checker{bankRepo}
+}
+
+// Check checks if the account number is plausible for the bank code.
+func (c *Checker) Check(bc, aNo string) Result {
+	res := Result{
+		BankCode:  bc,
+		AccountNo: aNo,
+	}
+	b, ok := c.bankRepo.Bank(iban.CountryCodeDE, bc)
+	if !ok {
+		res.Reason = ReasonUnknownBankCode
+		res.Err = errors.New("unknown bank code")
+		return res
+	}
+	res.Method = b.CheckMethod
+	if err := iban.CheckBankAccountNo(b.CheckMethod, bc, aNo); err != nil {
+		res.Err = err
+		switch {
+		case errors.Is(err, iban.ErrMalformedAccountNo):
+			res.Reason = ReasonMalformedAccountNo
+		case errors.Is(err, iban.ErrUnsupportedMethod):
+			res.Reason = ReasonUnsupportedMethod
+		default:
+			res.Reason = ReasonCheckDigit
+		}
+		return res
+	}
+	res.Valid = true
+	return res
+}
@@ -0,0 +1,42 @@
+package kontocheck
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/leonnicolas/iban-gen/bic"
+)
+
+// entry returns a line of the Bundesbank bank code file.
+func entry(bc, name, bic, method string) string {
+	return fmt.Sprintf("%-8s1%-58s%-5s%-35s%-27s%-5s%-11s%-2s%-6sU0%-8s\n", bc, name, "10591", "Berlin", name, "", bic, method, "000001", "00000000")
+}
+
+func TestCheck(t *testing.T) {
+	repo := bic.NewBICRepo()
+	if _, err := repo.Populate(strings.NewReader(
+		entry("37040044", "Commerzbank", "COBADEFFXXX", "13") +
+			entry("10000000", "Bundesbank", "MARKDEF1100", "ZZ"),
+	)); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	c := NewChecker(repo)
+	for _, tc := range []struct {
+		bc     string
+		aNo    string
+		method string
+		reason Reason
+	}{
+		{bc: "37040044", aNo: "0532013000", method: "13"},
+		{bc: "37040044", aNo: "0532014000", method: "13", reason: ReasonCheckDigit},
+		{bc: "37040044", aNo: "05320130001", method: "13", reason: ReasonMalformedAccountNo},
+		{bc: "10000000", aNo: "0532013000", method: "ZZ", reason: ReasonUnsupportedMethod},
+		{bc: "12345678", aNo: "0532013000", reason: ReasonUnknownBankCode},
+	} {
+		res := c.Check(tc.bc, tc.aNo)
+		if res.Valid != (tc.reason == "") || res.Reason != tc.reason || res.Method != tc.method {
+			t.Errorf("%s %s: got=%+v expected method=%q reason=%q\n", tc.bc, tc.aNo, res, tc.method, tc.reason)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+// Package redact masks IBANs in go-kit log pipelines,
+// so that real account numbers never reach the log output.
+package redact
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+type logger struct {
+	next log.Logger
+}
+
+// NewLogger returns a Logger that masks all IBANs in the values of the key/value pairs with Value
+// before passing them to next.
+// It should wrap the logger that writes the output, so that e.g. level filters still see the original values.
+func NewLogger(next log.Logger) log.Logger {
+	return &logger{next: next}
+}
+
+// Log implements the log.Logger interface.
+func (l *logger) Log(keyvals ...interface{}) error {
+	kvs := make([]interface{}, len(keyvals))
+	for i, v := range keyvals {
+		if i%2 == 1 {
+			v = Value(v)
+		}
+		kvs[i] = v
+	}
+	return l.next.Log(kvs...)
+}
+
+// Valuer returns a Valuer that masks all IBANs in the values of v with Value.
+func Valuer(v log.Valuer) log.Valuer {
+	return func() interface{} {
+		return Value(v())
+	}
+}
+
+// Value returns v with all IBANs masked with iban.Redact, which keeps the country code, the check digits
+// and the last four characters.
+// Strings, named string types and errors are redacted as text, other values like structs and maps
+// in both their text and JSON encoding, so that an IBAN is masked even if a whole request is logged.
+// Values without IBANs are returned as they are.
+func Value(v interface{}) interface{} {
+	switch x := v.(type) {
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v
+	case string:
+		return iban.Redact(x)
+	case []byte:
+		if r := iban.Redact(string(x)); r != string(x) {
+			return []byte(r)
+		}
+		return v
+	case error:
+		if s, ok := safeText(x.Error); ok {
+			if r := iban.Redact(s); r != s {
+				return r
+			}
+		}
+		return v
+	}
+	// Named string types like "type AccountID string" are redacted as their underlying string.
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		if r := iban.Redact(rv.String()); r != rv.String() {
+			return r
+		}
+	}
+	switch v.(type) {
+	case encoding.TextMarshaler, json.Marshaler, fmt.Stringer:
+	default:
+		switch reflect.ValueOf(v).Kind() {
+		case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr, reflect.Interface:
+		default:
+			return v
+		}
+	}
+	return redactStructured(v)
+}
+
+// redacted is a structured value with masked IBANs.
+// It implements json.Marshaler for JSON loggers and fmt.Stringer for logfmt loggers.
+type redacted struct {
+	json []byte
+	text string
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r redacted) MarshalJSON() ([]byte, error) {
+	return r.json, nil
+}
+
+// String implements the fmt.Stringer interface.
+func (r redacted) String() string {
+	return r.text
+}
+
+// redactStructured masks the IBANs in the text and JSON encoding of v.
+func redactStructured(v interface{}) interface{} {
+	text, ok := textOf(v)
+	if !ok {
+		return v
+	}
+	rText := iban.Redact(text)
+	b, changed := redactJSON(v)
+	if rText == text && !changed {
+		return v
+	}
+	// JSON loggers log fmt.Stringers without marshalers as text.
+	_, isJSON := v.(json.Marshaler)
+	_, isText := v.(encoding.TextMarshaler)
+	if _, isStringer := v.(fmt.Stringer); b == nil || (isStringer && !isJSON && !isText) {
+		b, _ = json.Marshal(rText)
+	}
+	return redacted{json: b, text: rText}
+}
+
+// textOf returns the text encoding of v like logfmt loggers do.
+func textOf(v interface{}) (string, bool) {
+	switch x := v.(type) {
+	case encoding.TextMarshaler:
+		var b []byte
+		s, ok := safeText(func() string {
+			var err error
+			if b, err = x.MarshalText(); err != nil {
+				panic(err)
+			}
+			return string(b)
+		})
+		return s, ok
+	case fmt.Stringer:
+		return safeText(x.String)
+	}
+	return fmt.Sprintf("%+v", v), true
+}
+
+// redactJSON returns the JSON encoding of v with masked IBANs and reports whether anything was masked.
+// It returns nil if v cannot be encoded as JSON.
+func redactJSON(v interface{}) ([]byte, bool) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var tree interface{}
+	if err := d.Decode(&tree); err != nil {
+		return nil, false
+	}
+	tree, changed := redactTree(tree)
+	if !changed {
+		return b, false
+	}
+	if b, err = json.Marshal(tree); err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// redactTree masks the IBANs in all keys and strings of a decoded JSON value
+// and reports whether anything was masked.
+func redactTree(v interface{}) (interface{}, bool) {
+	switch x := v.(type) {
+	case string:
+		r := iban.Redact(x)
+		return r, r != x
+	case []interface{}:
+		changed := false
+		for i := range x {
+			var c bool
+			if x[i], c = redactTree(x[i]); c {
+				changed = true
+			}
+		}
+		return x, changed
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(x))
+		changed := false
+		for k, e := range x {
+			rk := iban.Redact(k)
+			re, c := redactTree(e)
+			if c || rk != k {
+				changed = true
+			}
+			ret[rk] = re
+		}
+		return ret, changed
+	}
+	return v, false
+}
+
+// safeText calls f and recovers from panics, e.g. of methods on nil pointers.
+func safeText(f func() string) (s string, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return f(), true
+}
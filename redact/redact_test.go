@@ -0,0 +1,108 @@
+package redact
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+type request struct {
+	Name     string
+	IBAN     string `json:"iban"`
+	Counter  *iban.IBAN
+	Accounts map[string][]string
+}
+
+type accountID string
+
+type account struct {
+	iban string
+}
+
+func (a account) String() string {
+	return "account " + a.iban
+}
+
+func TestLogger(t *testing.T) {
+	i, _ := iban.Parse("GB82WEST12345698765432")
+	for _, tc := range []struct {
+		name string
+		kvs  []interface{}
+	}{
+		{name: "string", kvs: []interface{}{"iban", "DE89370400440532013000"}},
+		{name: "named string", kvs: []interface{}{"account", accountID("DE89370400440532013000")}},
+		{name: "mixed case", kvs: []interface{}{"iban", "De89370400440532013000"}},
+		{name: "dots", kvs: []interface{}{"iban", "DE89.3704.0044.0532.0130.00"}},
+		{name: "wrong length", kvs: []interface{}{"iban", "DE89370400440532013000999"}},
+		{name: "struct with named string", kvs: []interface{}{"request", struct{ ID accountID }{"de89 3704 0044 0532 0130 00"}}},
+		{name: "print format", kvs: []interface{}{"msg", "pay to IBAN: DE89 3704 0044 0532 0130 00 today"}},
+		{name: "bytes", kvs: []interface{}{"body", []byte(`{"iban":"de89370400440532013000"}`)}},
+		{name: "error", kvs: []interface{}{"err", fmt.Errorf("invalid account %s", "DE89370400440532013000")}},
+		{name: "iban", kvs: []interface{}{"iban", i}},
+		{name: "stringer", kvs: []interface{}{"account", account{iban: "DE89370400440532013000"}}},
+		{name: "struct", kvs: []interface{}{"request", request{
+			Name:     "DE89370400440532013000",
+			IBAN:     "DE89370400440532013000",
+			Counter:  i,
+			Accounts: map[string][]string{"DE89370400440532013000": {"GB82WEST12345698765432"}},
+		}}},
+		{name: "pointer", kvs: []interface{}{"request", &request{IBAN: "DE89370400440532013000"}}},
+		{name: "valuer", kvs: []interface{}{"iban", Valuer(func() interface{} { return "DE89370400440532013000" })}},
+	} {
+		for _, f := range []struct {
+			name string
+			new  func(w *bytes.Buffer) log.Logger
+		}{
+			{name: "json", new: func(w *bytes.Buffer) log.Logger { return log.NewJSONLogger(w) }},
+			{name: "logfmt", new: func(w *bytes.Buffer) log.Logger { return log.NewLogfmtLogger(w) }},
+		} {
+			var buf bytes.Buffer
+			l := NewLogger(f.new(&buf))
+			kvs := tc.kvs
+			if tc.name == "valuer" {
+				l, kvs = log.With(l, kvs...), nil
+			}
+			if err := l.Log(kvs...); err != nil {
+				t.Errorf("%s %s: got err=%q\n", tc.name, f.name, err.Error())
+			}
+			out := strings.ToUpper(buf.String())
+			for _, s := range []string{"3704004405320130", "WEST1234569876", "3704 0044 0532 0130", "3704.0044.0532.0130"} {
+				if strings.Contains(out, s) {
+					t.Errorf("%s %s: got unmasked IBAN in %s\n", tc.name, f.name, buf.String())
+				}
+			}
+			// JSON loggers encode bytes with base64.
+			if !strings.Contains(out, "****") && (tc.name != "bytes" || f.name != "json") {
+				t.Errorf("%s %s: got no masked IBAN in %s\n", tc.name, f.name, buf.String())
+			}
+		}
+	}
+}
+
+func TestValueUnchanged(t *testing.T) {
+	err := errors.New("no account")
+	lvl := level.InfoValue()
+	r := &request{Name: "alice"}
+	for _, v := range []interface{}{nil, 42, "hello", err, lvl, r} {
+		if got := Value(v); got != v {
+			t.Errorf("got %v expected=%v\n", got, v)
+		}
+	}
+}
+
+func TestLevelFilter(t *testing.T) {
+	var buf bytes.Buffer
+	l := level.NewFilter(NewLogger(log.NewLogfmtLogger(&buf)), level.AllowInfo())
+	level.Debug(l).Log("iban", "DE89370400440532013000")
+	level.Info(l).Log("iban", "DE89370400440532013000")
+	if got, exp := buf.String(), "level=info iban=DE89**************3000\n"; got != exp {
+		t.Errorf("got %q expected=%q\n", got, exp)
+	}
+}
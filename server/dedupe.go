@@ -0,0 +1,46 @@
+package server
+
+import (
+	"hash/fnv"
+)
+
+const (
+	// dedupeBits is the number of bits of the Bloom filter per iban of a batch.
+	dedupeBits = 10
+	// dedupeHashes is the number of bits that are set per iban,
+	// which is optimal for dedupeBits and a false positive rate below one percent.
+	dedupeHashes = 7
+)
+
+// dedupe is a Bloom filter that remembers the ibans of a batch with a fixed number of bits per iban,
+// so that a stream of a million ibans needs little more than a megabyte.
+// A false positive skips an iban, but never causes a duplicate.
+type dedupe struct {
+	bits []uint64
+}
+
+// newDedupe returns a dedupe for n ibans.
+func newDedupe(n int) *dedupe {
+	return &dedupe{
+		bits: make([]uint64, (n*dedupeBits+63)/64),
+	}
+}
+
+// add adds the iban and reports whether it was not seen before.
+func (d *dedupe) add(s string) bool {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	sum := h.Sum64()
+	// Double hashing derives all bit positions from two halves of one hash.
+	h1, h2 := sum&0xffffffff, sum>>32|1
+	m := uint64(len(d.bits) * 64)
+	added := false
+	for i := uint64(0); i < dedupeHashes; i++ {
+		b := (h1 + i*h2) % m
+		if d.bits[b/64]&(1<<(b%64)) == 0 {
+			d.bits[b/64] |= 1 << (b % 64)
+			added = true
+		}
+	}
+	return added
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const contentTypeNDJSON = "application/x-ndjson"
+
+// flushEvery is the number of lines after which a NDJSON stream is flushed.
+const flushEvery = 100
+
+// acceptsNDJSON reports whether the client requested newline-delimited JSON.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, a := range strings.Split(r.Header.Get("Accept"), ",") {
+		if t, _, err := mime.ParseMediaType(strings.TrimSpace(a)); err == nil && t == contentTypeNDJSON {
+			return true
+		}
+	}
+	return false
+}
+
+// ndjsonWriter writes values as newline-delimited JSON.
+// Writes block while the client does not read, so a slow client slows down the producer.
+type ndjsonWriter struct {
+	w   http.ResponseWriter
+	enc *json.Encoder
+	n   int
+}
+
+func newNDJSONWriter(w http.ResponseWriter) *ndjsonWriter {
+	return &ndjsonWriter{
+		w:   w,
+		enc: json.NewEncoder(w),
+	}
+}
+
+// write writes one line and flushes every flushEvery lines.
+func (nw *ndjsonWriter) write(v interface{}) error {
+	if nw.n == 0 {
+		nw.w.Header().Set("Content-Type", contentTypeNDJSON)
+	}
+	if err := nw.enc.Encode(v); err != nil {
+		return err
+	}
+	nw.n++
+	if nw.n%flushEvery == 0 {
+		nw.flush()
+	}
+	return nil
+}
+
+// written reports whether a line was written, after which the status code cannot change anymore.
+func (nw *ndjsonWriter) written() bool {
+	return nw.n > 0
+}
+
+func (nw *ndjsonWriter) flush() {
+	if f, ok := nw.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+
+	v1 "github.com/leonnicolas/iban-gen/api/v1"
+	"github.com/leonnicolas/iban-gen/bic"
+	"github.com/leonnicolas/iban-gen/iban"
+)
+
+func TestAcceptsNDJSON(t *testing.T) {
+	for _, tc := range []struct {
+		accept   string
+		expected bool
+	}{
+		{accept: ""},
+		{accept: "application/json"},
+		{accept: "*/*"},
+		{accept: "application/x-ndjson", expected: true},
+		{accept: "application/json, application/x-ndjson;q=0.9", expected: true},
+		{accept: "APPLICATION/X-NDJSON", expected: true},
+		{accept: "application/x-ndjsonx"},
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/v1/randomBatch", nil)
+		r.Header.Set("Accept", tc.accept)
+		if got := acceptsNDJSON(r); got != tc.expected {
+			t.Errorf("%q: got %v expected=%v\n", tc.accept, got, tc.expected)
+		}
+	}
+}
+
+// lines returns the lines of a NDJSON body.
+func lines(t *testing.T, body []byte) []string {
+	var ret []string
+	s := bufio.NewScanner(bytes.NewReader(body))
+	for s.Scan() {
+		ret = append(ret, s.Text())
+	}
+	if err := s.Err(); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	return ret
+}
+
+// streamWriter is a ResponseWriter that calls hook before every write and counts flushes.
+type streamWriter struct {
+	*httptest.ResponseRecorder
+	writes  int
+	flushes int
+	hook    func(n int) error
+}
+
+func (sw *streamWriter) Write(b []byte) (int, error) {
+	sw.writes++
+	if sw.hook != nil {
+		if err := sw.hook(sw.writes); err != nil {
+			return 0, err
+		}
+	}
+	return sw.ResponseRecorder.Write(b)
+}
+
+func (sw *streamWriter) Flush() {
+	sw.flushes++
+	sw.ResponseRecorder.Flush()
+}
+
+// stream requests a NDJSON stream of n ibans from randomBatch with the context.
+func stream(ctx context.Context, w http.ResponseWriter, n int) {
+	s := newWithLogger(bic.NewBICRepo(), log.NewNopLogger())
+	cc := "NL"
+	params := v1.RandomBatchParams{Count: n, CountryCode: &cc}
+	r := httptest.NewRequest(http.MethodGet, "/v1/randomBatch", nil).WithContext(ctx)
+	r.Header.Set("Accept", contentTypeNDJSON)
+	s.randomBatch(w, r, params)(w, r)
+}
+
+func TestRandomBatchNDJSON(t *testing.T) {
+	ts := newTestServer(t, 0)
+	for _, tc := range []struct {
+		accept      string
+		count       int
+		code        int
+		contentType string
+	}{
+		{count: 10, code: http.StatusOK, contentType: "application/json"},
+		{count: maxRandomBatch + 1, code: http.StatusBadRequest, contentType: "application/json"},
+		{accept: contentTypeNDJSON, count: 1, code: http.StatusOK, contentType: contentTypeNDJSON},
+		{accept: contentTypeNDJSON, count: 5000, code: http.StatusOK, contentType: contentTypeNDJSON},
+		{accept: contentTypeNDJSON, count: maxRandomStream + 1, code: http.StatusBadRequest, contentType: "application/json"},
+	} {
+		name := fmt.Sprintf("%q %d", tc.accept, tc.count)
+		r, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v1/randomBatch?count=%d&countryCode=NL", ts.URL, tc.count), nil)
+		if err != nil {
+			t.Fatalf("got err=%q\n", err.Error())
+		}
+		r.Header.Set("Accept", tc.accept)
+		res, err := http.DefaultClient.Do(r)
+		if err != nil {
+			t.Fatalf("got err=%q\n", err.Error())
+		}
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(res.Body)
+		res.Body.Close()
+		if err != nil {
+			t.Fatalf("got err=%q\n", err.Error())
+		}
+		if res.StatusCode != tc.code {
+			t.Errorf("%s: got status=%d expected=%d: %s\n", name, res.StatusCode, tc.code, buf.String())
+			continue
+		}
+		if ct := res.Header.Get("Content-Type"); ct != tc.contentType {
+			t.Errorf("%s: got content type %q expected=%q\n", name, ct, tc.contentType)
+		}
+		if tc.code != http.StatusOK || tc.accept == "" {
+			continue
+		}
+		ls := lines(t, buf.Bytes())
+		if len(ls) != tc.count {
+			t.Errorf("%s: got %d lines expected=%d\n", name, len(ls), tc.count)
+		}
+		seen := make(map[string]bool, len(ls))
+		for _, l := range ls {
+			var g v1.IBANGeneration
+			if err := json.Unmarshal([]byte(l), &g); err != nil || !strings.HasPrefix(g.Iban, "NL") {
+				t.Fatalf("%s: got line %q and err=%v\n", name, l, err)
+			}
+			if seen[g.Iban] {
+				t.Errorf("%s: got %s twice\n", name, g.Iban)
+			}
+			seen[g.Iban] = true
+		}
+	}
+}
+
+func TestRandomBatchNDJSONFlush(t *testing.T) {
+	n := 2*flushEvery + 1
+	sw := &streamWriter{ResponseRecorder: httptest.NewRecorder()}
+	stream(context.Background(), sw, n)
+	if got := len(lines(t, sw.Body.Bytes())); got != n {
+		t.Fatalf("got %d lines expected=%d\n", got, n)
+	}
+	// The stream is flushed every flushEvery lines and at the end.
+	if sw.flushes != n/flushEvery+1 {
+		t.Errorf("got %d flushes expected=%d\n", sw.flushes, n/flushEvery+1)
+	}
+}
+
+func TestRandomBatchNDJSONCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// The client goes away after 10 lines of a stream of a million.
+	sw := &streamWriter{
+		ResponseRecorder: httptest.NewRecorder(),
+		hook: func(n int) error {
+			if n == 10 {
+				cancel()
+			}
+			return nil
+		},
+	}
+	stream(ctx, sw, maxRandomStream)
+	ls := lines(t, sw.Body.Bytes())
+	if len(ls) != 10 {
+		t.Errorf("got %d lines expected=%d\n", len(ls), 10)
+	}
+	if sw.Code != http.StatusOK {
+		t.Errorf("got status=%d expected=%d\n", sw.Code, http.StatusOK)
+	}
+}
+
+func TestRandomBatchNDJSONWriteError(t *testing.T) {
+	// The client connection breaks at the 6th line, which ends the stream.
+	sw := &streamWriter{
+		ResponseRecorder: httptest.NewRecorder(),
+		hook: func(n int) error {
+			if n == 6 {
+				return errors.New("broken pipe")
+			}
+			return nil
+		},
+	}
+	stream(context.Background(), sw, 100)
+	if sw.Code != http.StatusOK {
+		t.Errorf("got status=%d expected=%d\n", sw.Code, http.StatusOK)
+	}
+	if got := len(lines(t, sw.Body.Bytes())); got != 5 {
+		t.Errorf("got %d lines expected=%d\n", got, 5)
+	}
+}
+
+func TestEndStream(t *testing.T) {
+	s := newWithLogger(bic.NewBICRepo(), log.NewNopLogger())
+	failed := fmt.Errorf("%w: found only 3 ibans", iban.ErrBatchTooLarge)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		written int
+		err     error
+		code    int
+		lines   int
+		last    string
+	}{
+		{name: "done", ctx: context.Background(), written: 3, code: http.StatusOK, lines: 3},
+		{name: "error before the first line", ctx: context.Background(), err: failed, code: http.StatusBadRequest, lines: 1, last: failed.Error()},
+		// The status code was already sent, so the error is the last line.
+		{name: "error after the first line", ctx: context.Background(), written: 3, err: failed, code: http.StatusOK, lines: 4, last: failed.Error()},
+		{name: "cancelled", ctx: cancelled, written: 3, err: cancelled.Err(), code: http.StatusOK, lines: 3},
+	} {
+		sw := &streamWriter{ResponseRecorder: httptest.NewRecorder()}
+		r := httptest.NewRequest(http.MethodGet, "/v1/randomBatch", nil).WithContext(tc.ctx)
+		nw := newNDJSONWriter(sw)
+		for i := 0; i < tc.written; i++ {
+			if err := nw.write(v1.IBANGeneration{Iban: fmt.Sprintf("NL%016d", i)}); err != nil {
+				t.Fatalf("got err=%q\n", err.Error())
+			}
+		}
+		s.endStream(sw, r, nw, http.StatusBadRequest, tc.err)
+		if sw.Code != tc.code {
+			t.Errorf("%s: got status=%d expected=%d\n", tc.name, sw.Code, tc.code)
+		}
+		ls := lines(t, sw.Body.Bytes())
+		if len(ls) != tc.lines {
+			t.Errorf("%s: got %d lines expected=%d\n", tc.name, len(ls), tc.lines)
+			continue
+		}
+		if tc.last != "" {
+			var e v1.Error
+			if err := json.Unmarshal([]byte(ls[len(ls)-1]), &e); err != nil || e.Error != tc.last {
+				t.Errorf("%s: got last line %q expected error=%q\n", tc.name, ls[len(ls)-1], tc.last)
+			}
+		}
+		if tc.written > 0 && sw.flushes == 0 {
+			t.Errorf("%s: got no flush\n", tc.name)
+		}
+	}
+}
+
+func TestRandomBatchNDJSONRejected(t *testing.T) {
+	// Batches that fail the checks get an error status code instead of a stream.
+	s := newWithLogger(bic.NewBICRepo(), log.NewNopLogger())
+	for _, tc := range []struct {
+		cc string
+		bc string
+	}{
+		{cc: "XX"},
+		// The bank has fewer valid account numbers than the stream.
+		{cc: "NO", bc: "8601"},
+	} {
+		sw := &streamWriter{ResponseRecorder: httptest.NewRecorder()}
+		cc, bc := tc.cc, tc.bc
+		r := httptest.NewRequest(http.MethodGet, "/v1/randomBatch", nil)
+		r.Header.Set("Accept", contentTypeNDJSON)
+		s.randomBatch(sw, r, v1.RandomBatchParams{Count: maxRandomStream, CountryCode: &cc, BankCode: &bc})(sw, r)
+		if sw.Code != http.StatusBadRequest || sw.Header().Get("Content-Type") != "application/json" {
+			t.Errorf("%s %s: got status=%d and content type %q expected=%d\n", cc, bc, sw.Code, sw.Header().Get("Content-Type"), http.StatusBadRequest)
+		}
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	n := 100000
+	d := newDedupe(n)
+	if !d.add("DE89370400440532013000") {
+		t.Errorf("got a new iban as seen\n")
+	}
+	if d.add("DE89370400440532013000") {
+		t.Errorf("got a seen iban as new\n")
+	}
+	// False positives skip ibans, but less than one percent of them.
+	fp := 0
+	for i := 0; i < n; i++ {
+		if !d.add(fmt.Sprintf("NL%020d", i)) {
+			fp++
+		}
+	}
+	if fp > n/100 {
+		t.Errorf("got %d false positives for %d ibans\n", fp, n)
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+
+	v1 "github.com/leonnicolas/iban-gen/api/v1"
+	"github.com/leonnicolas/iban-gen/iban"
+	"github.com/leonnicolas/iban-gen/version"
+)
+
+var (
+	errMalformedReplay = errors.New("malformed replay token")
+	errStaleReplay     = errors.New("replay token was created with different bank data or a different version")
+)
+
+// replayToken contains everything that is needed to repeat a generation.
+type replayToken struct {
+	// Op is the operation that created the token.
+	Op string `json:"o"`
+	// Version is the version of the inputs of the generation, see dataVersion.
+	Version string `json:"v"`
+	Seed    int64  `json:"s"`
+	// Params are the request parameters without seed and replay token.
+	Params json.RawMessage `json:"p"`
+}
+
+// seed returns the seed of the request or a random seed.
+func seed(s *v1.Seed) int64 {
+	if s != nil {
+		return int64(*s)
+	}
+	return rand.Int63()
+}
+
+// generator returns a Generator with the given seed.
+func generator(seed int64) *iban.Generator {
+	return iban.NewGenerator(rand.NewSource(seed))
+}
+
+// dataVersion returns a version of everything besides the seed and the parameters that a generation depends on:
+// the bank data, the sort code table and the version of iban-gen, which can change how ibans are generated.
+func (s *server) dataVersion() string {
+	h := sha256.New()
+	for _, v := range []string{s.bicsRepo.Version(), iban.SortCodeTable().Version(), version.Version} {
+		h.Write([]byte(v + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// encodeReplay returns an opaque replay token for the operation.
+func (s *server) encodeReplay(op string, seed int64, params interface{}) (string, error) {
+	p, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	t, err := json.Marshal(replayToken{
+		Op:      op,
+		Version: s.dataVersion(),
+		Seed:    seed,
+		Params:  p,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(t), nil
+}
+
+// decodeReplay decodes the replay token of the operation into params and returns its seed.
+func (s *server) decodeReplay(op, token string, params interface{}) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errMalformedReplay
+	}
+	var t replayToken
+	if err := json.Unmarshal(b, &t); err != nil || t.Op != op {
+		return 0, errMalformedReplay
+	}
+	if t.Version != s.dataVersion() {
+		return 0, errStaleReplay
+	}
+	if err := json.Unmarshal(t.Params, params); err != nil {
+		return 0, fmt.Errorf("%w: %v", errMalformedReplay, err)
+	}
+	return t.Seed, nil
+}
+
+// replayStatus returns the http status code for an error of decodeReplay.
+func replayStatus(err error) int {
+	if errors.Is(err, errStaleReplay) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
@@ -2,6 +2,9 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
 	"net/http"
 	"strings"
 
@@ -15,6 +18,7 @@ import (
 	v1 "github.com/leonnicolas/iban-gen/api/v1"
 	"github.com/leonnicolas/iban-gen/bic"
 	"github.com/leonnicolas/iban-gen/iban"
+	"github.com/leonnicolas/iban-gen/kontocheck"
 )
 
 type instrumentedServer struct {
@@ -39,6 +43,70 @@ func (s *instrumentedServer) Random(w http.ResponseWriter, r *http.Request, para
 	h(w, r)
 }
 
+// RandomBatch returns multiple random ibans.
+func (s *instrumentedServer) RandomBatch(w http.ResponseWriter, r *http.Request, params v1.RandomBatchParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "randomBatch"},
+		http.HandlerFunc(s.server.randomBatch(w, r, params)),
+	)(w, r)
+}
+
+// Enumerate returns the ibans of a bank page by page.
+func (s *instrumentedServer) Enumerate(w http.ResponseWriter, r *http.Request, params v1.EnumerateParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "enumerate"},
+		http.HandlerFunc(s.server.enumerate(w, r, params)),
+	)(w, r)
+}
+
+// Edgecases returns edge case ibans of a country.
+func (s *instrumentedServer) Edgecases(w http.ResponseWriter, r *http.Request, params v1.EdgecasesParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "edgecases"},
+		http.HandlerFunc(s.server.edgecases(w, r, params)),
+	)(w, r)
+}
+
+// Typos returns typo variants of an iban.
+func (s *instrumentedServer) Typos(w http.ResponseWriter, r *http.Request, params v1.TyposParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "typos"},
+		http.HandlerFunc(s.server.typos(w, r, params)),
+	)(w, r)
+}
+
+// Validate validates an iban.
+func (s *instrumentedServer) Validate(w http.ResponseWriter, r *http.Request, params v1.ValidateParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "validate"},
+		http.HandlerFunc(s.server.validate(w, r, params)),
+	)(w, r)
+}
+
+// ValidateBatch validates multiple ibans.
+func (s *instrumentedServer) ValidateBatch(w http.ResponseWriter, r *http.Request) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "validateBatch"},
+		http.HandlerFunc(s.server.validateBatch(w, r)),
+	)(w, r)
+}
+
+// Kontocheck checks a German account number.
+func (s *instrumentedServer) Kontocheck(w http.ResponseWriter, r *http.Request, params v1.KontocheckParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "kontocheck"},
+		http.HandlerFunc(s.server.kontocheck(w, r, params)),
+	)(w, r)
+}
+
+// Convert converts a German account to an iban.
+func (s *instrumentedServer) Convert(w http.ResponseWriter, r *http.Request, params v1.ConvertParams) {
+	s.instrumenter.NewHandler(
+		prometheus.Labels{"handler": "convert"},
+		http.HandlerFunc(s.server.convert(w, r, params)),
+	)(w, r)
+}
+
 // Bics returns BICs.
 func (s *instrumentedServer) Bics(w http.ResponseWriter, r *http.Request, params v1.BicsParams) {
 	h := s.instrumenter.NewHandler(
@@ -58,13 +126,14 @@ func (s *instrumentedServer) CountryCodes(w http.ResponseWriter, r *http.Request
 
 type server struct {
 	bicsRepo  *bic.BankRepo
+	checker   *kontocheck.Checker
 	logger    log.Logger
 	httpError func(w http.ResponseWriter, m string, code int)
 }
 
 // newWithLogger returns a new Server.
 func newWithLogger(bicsRepo *bic.BankRepo, logger log.Logger) server {
-	return server{bicsRepo, logger, httpError(logger)}
+	return server{bicsRepo, kontocheck.NewChecker(bicsRepo), logger, httpError(logger)}
 }
 
 func httpError(logger log.Logger) func(w http.ResponseWriter, m string, code int) {
@@ -83,39 +152,616 @@ func httpError(logger log.Logger) func(w http.ResponseWriter, m string, code int
 // random returns a random iban.
 func (s *server) random(w http.ResponseWriter, r *http.Request, params v1.RandomParams) func(rw http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var i *iban.IBAN
 		var err error
-		if params.Bic != nil && *params.Bic != "" {
-			bc, ok := s.bicsRepo.BankCode(*params.Bic)
-			if !ok {
-				s.httpError(w, "unknown bic", http.StatusNotFound)
+		sd := seed(params.Seed)
+		if params.Replay != nil && *params.Replay != "" {
+			token := string(*params.Replay)
+			params = v1.RandomParams{}
+			if sd, err = s.decodeReplay("random", token, &params); err != nil {
+				s.httpError(w, err.Error(), replayStatus(err))
 				return
 			}
-			i, err = iban.GenerateFromBankCode(iban.CountryCodeDE, bc)
-			if err != nil {
-				s.httpError(w, err.Error(), http.StatusInternalServerError)
-				return
+		}
+		params.Seed, params.Replay = nil, nil
+		res, code, err := s.generation(sd, params)
+		if err != nil {
+			s.httpError(w, err.Error(), code)
+			return
+		}
+		if acceptsNDJSON(r) {
+			if err := newNDJSONWriter(w).write(res); err != nil {
+				level.Error(s.logger).Log("msg", "failed to write response", "err", err.Error())
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// maxRandomBatch is the maximum number of ibans that can be generated in one request.
+const maxRandomBatch = 1000
+
+// maxRandomStream is the maximum number of ibans that can be generated in one NDJSON stream.
+// It bounds the memory of the dedupe of a stream to little more than a megabyte.
+const maxRandomStream = 1000000
+
+// randomBatch returns multiple distinct random ibans.
+func (s *server) randomBatch(w http.ResponseWriter, r *http.Request, params v1.RandomBatchParams) func(rw http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stream := acceptsNDJSON(r)
+		max := maxRandomBatch
+		if stream {
+			max = maxRandomStream
+		}
+		if params.Count < 1 || params.Count > max {
+			s.httpError(w, fmt.Sprintf("count must be between 1 and %d", max), http.StatusBadRequest)
+			return
+		}
+		p := v1.RandomParams{
+			Bic:         params.Bic,
+			BankCode:    params.BankCode,
+			CountryCode: params.CountryCode,
+			QrIban:      params.QrIban,
+			QrReference: params.QrReference,
+			Format:      params.Format,
+		}
+		cc, bc, method, code, err := s.criteria(p)
+		if err != nil {
+			s.httpError(w, err.Error(), code)
+			return
+		}
+		// Batches that are too large are rejected before a stream starts.
+		if err := iban.CheckBatch(params.Count, cc, bc, method); errors.Is(err, iban.ErrUnsupportedMethod) {
+			s.httpError(w, err.Error(), http.StatusNotImplemented)
+			return
+		} else if err != nil {
+			s.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if stream {
+			nw := newNDJSONWriter(w)
+			code, err := s.generateBatch(r, seed(params.Seed), p, params.Count, func(g v1.IBANGeneration) error {
+				return nw.write(g)
+			})
+			s.endStream(w, r, nw, code, err)
+			return
+		}
+		res := make([]v1.IBANGeneration, 0, params.Count)
+		if code, err := s.generateBatch(r, seed(params.Seed), p, params.Count, func(g v1.IBANGeneration) error {
+			res = append(res, g)
+			return nil
+		}); err != nil {
+			s.httpError(w, err.Error(), code)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// endStream ends a NDJSON stream with the error of generateBatch and its http status code.
+func (s *server) endStream(w http.ResponseWriter, r *http.Request, nw *ndjsonWriter, code int, err error) {
+	switch {
+	case err == nil:
+	case r.Context().Err() != nil:
+		level.Debug(s.logger).Log("msg", "stream was cancelled", "err", r.Context().Err().Error())
+	case !nw.written():
+		s.httpError(w, err.Error(), code)
+		return
+	default:
+		// The status code was already sent, so the error is reported as the last line.
+		if err := nw.write(v1.Error{Error: err.Error()}); err != nil {
+			level.Error(s.logger).Log("msg", "failed to write response", "err", err.Error())
+		}
+	}
+	nw.flush()
+}
 
+// generateBatch generates n distinct ibans and passes them to emit.
+// It stops when emit fails or the request is cancelled
+// and returns the http status code of an error.
+func (s *server) generateBatch(r *http.Request, sd int64, params v1.RandomParams, n int, emit func(v1.IBANGeneration) error) (int, error) {
+	// Every iban is generated with its own seed,
+	// so that its replay token reproduces it with /v1/random.
+	seeds := rand.New(rand.NewSource(sd))
+	seen := newDedupe(n)
+	for c, dups := 0, 0; c < n; {
+		if err := r.Context().Err(); err != nil {
+			return http.StatusServiceUnavailable, err
+		}
+		g, code, err := s.generation(seeds.Int63(), params)
+		if err != nil {
+			return code, err
+		}
+		if !seen.add(g.Iban) {
+			if dups++; dups > maxRandomBatch {
+				return http.StatusBadRequest, fmt.Errorf("%w: found only %d ibans", iban.ErrBatchTooLarge, c)
 			}
-		} else if params.BankCode != nil && *params.BankCode != "" {
-			i, err = iban.GenerateFromBankCode(iban.CountryCodeDE, *params.BankCode)
-			if err != nil {
-				s.httpError(w, err.Error(), http.StatusBadRequest)
-				return
+			continue
+		}
+		dups = 0
+		if err := emit(g); err != nil {
+			return http.StatusInternalServerError, err
+		}
+		c++
+	}
+	return 0, nil
+}
+
+// criteria returns the country code, bank code and check method to generate ibans for
+// and the http status code of an error.
+func (s *server) criteria(params v1.RandomParams) (iban.CountryCode, string, string, int, error) {
+	cc := iban.CountryCode(iban.CountryCodeDE)
+	if params.CountryCode != nil && normalizeCountryCode(*params.CountryCode) != "" {
+		cc = normalizeCountryCode(*params.CountryCode)
+	}
+	if params.Bic != nil && *params.Bic != "" {
+		bc, ok := s.bicsRepo.BankCode(*params.Bic)
+		if !ok {
+			return "", "", "", http.StatusNotFound, errors.New("unknown bic")
+		}
+		b, _ := s.bicsRepo.Bank(iban.CountryCodeDE, bc)
+		return iban.CountryCodeDE, bc, b.CheckMethod, 0, nil
+	}
+	if params.BankCode != nil && *params.BankCode != "" {
+		b, _ := s.bicsRepo.Bank(cc, *params.BankCode)
+		return cc, *params.BankCode, b.CheckMethod, 0, nil
+	}
+	if params.QrIban != nil && (params.CountryCode == nil || normalizeCountryCode(*params.CountryCode) == "") {
+		cc = iban.CountryCodeCH
+	}
+	return cc, "", "", 0, nil
+}
+
+// normalizeCountryCode returns the country code in upper case without surrounding whitespace,
+// so that e.g. "de" is accepted like lower case BICs and IBANs.
+func normalizeCountryCode(cc string) iban.CountryCode {
+	return iban.CountryCode(strings.ToUpper(strings.TrimSpace(cc)))
+}
+
+// normalizeBIC returns the normalized bic or nil if no bic is given.
+func normalizeBIC(b *string) *string {
+	if b == nil || *b == "" {
+		return nil
+	}
+	n := bic.Normalize(*b)
+	return &n
+}
+
+// generation generates an iban with the seed and returns it
+// with its replay token and the http status code of an error.
+func (s *server) generation(sd int64, params v1.RandomParams) (v1.IBANGeneration, int, error) {
+	var i *iban.IBAN
+	g := generator(sd)
+	params.Bic = normalizeBIC(params.Bic)
+	if params.Template != nil && *params.Template != "" {
+		// All other generation parameters are ignored for templates.
+		params.Bic, params.BankCode, params.CountryCode, params.QrIban = nil, nil, nil, nil
+	}
+	cc, bc, method, code, err := s.criteria(params)
+	if err != nil {
+		return v1.IBANGeneration{}, code, err
+	}
+	switch {
+	case params.Template != nil && *params.Template != "":
+		code = http.StatusBadRequest
+		method = s.templateMethod(*params.Template)
+		switch i, err = g.GenerateFromTemplate(*params.Template, method); {
+		case errors.Is(err, iban.ErrNoCompletion):
+			code = http.StatusUnprocessableEntity
+		case errors.Is(err, iban.ErrSearchLimit):
+			// Valid ibans can match the template, so another seed can find one.
+			code = http.StatusServiceUnavailable
+		}
+	case bc != "":
+		code = http.StatusBadRequest
+		if params.Bic != nil && *params.Bic != "" {
+			code = http.StatusInternalServerError
+		}
+		i, err = g.GenerateFromBankCodeAndMethod(cc, bc, method)
+	case params.QrIban != nil:
+		code = http.StatusBadRequest
+		i, err = g.GenerateSwiss(cc, *params.QrIban)
+	default:
+		code = http.StatusBadRequest
+		i, err = g.GenerateForCountry(cc)
+	}
+	if errors.Is(err, iban.ErrUnsupportedMethod) {
+		code = http.StatusNotImplemented
+	}
+	if err != nil {
+		return v1.IBANGeneration{}, code, err
+	}
+	token, err := s.encodeReplay("random", sd, params)
+	if err != nil {
+		return v1.IBANGeneration{}, http.StatusInternalServerError, err
+	}
+	res := v1.IBANGeneration{
+		Bankcode:    i.BankCode(),
+		Iban:        i.String(),
+		Bic:         params.Bic,
+		ReplayToken: token,
+	}
+	if params.Invalid != nil {
+		d := iban.Defect(*params.Invalid)
+		if res.Iban, err = s.invalidate(g, i, d, method); err != nil {
+			return v1.IBANGeneration{}, http.StatusBadRequest, err
+		}
+		// Defects like letters can change the bank code, which then has no BIC.
+		if res.Bankcode = iban.DefectiveBankCode(i, res.Iban); res.Bankcode != i.BankCode() {
+			res.Bic = nil
+		}
+		defect := v1.IBANGenerationDefect(d)
+		res.Defect = &defect
+	}
+	if params.Format != nil && len(*params.Format) > 0 {
+		if res.Formats, err = formats(res.Iban, *params.Format); err != nil {
+			return v1.IBANGeneration{}, http.StatusBadRequest, err
+		}
+	}
+	if params.QrReference != nil && *params.QrReference {
+		ref := g.GenerateQRReference()
+		res.QrReference = &ref
+	}
+	return res, 0, nil
+}
+
+// formats returns the representations of an iban.
+func formats(in string, fs []string) (*v1.IBANFormats, error) {
+	if slices.Contains(fs, "all") {
+		fs = nil
+		for _, f := range iban.Formats() {
+			fs = append(fs, string(f))
+		}
+	}
+	res := &v1.IBANFormats{}
+	for _, f := range fs {
+		out, err := iban.FormatString(in, iban.Format(f))
+		if err != nil {
+			return nil, err
+		}
+		switch iban.Format(f) {
+		case iban.FormatElectronic:
+			res.Electronic = &out
+		case iban.FormatPrint:
+			res.Print = &out
+		case iban.FormatMasked:
+			res.Masked = &out
+		case iban.FormatURI:
+			res.Uri = &out
+		}
+	}
+	return res, nil
+}
+
+// templateMethod returns the check method of the bank of a German template
+// if the template fixes the bank code.
+func (s *server) templateMethod(tpl string) string {
+	t := strings.ToUpper(strings.ReplaceAll(tpl, " ", ""))
+	if len(t) < 12 || iban.CountryCode(t[:2]) != iban.CountryCodeDE {
+		return ""
+	}
+	b, _ := s.bicsRepo.Bank(iban.CountryCodeDE, t[4:12])
+	return b.CheckMethod
+}
+
+// invalidate applies the defect to the iban.
+// The check method is used for German account numbers.
+func (s *server) invalidate(g *iban.Generator, i *iban.IBAN, d iban.Defect, method string) (string, error) {
+	cc := iban.CountryCode(i.CountryCode())
+	switch {
+	case d == iban.DefectBankCode:
+		return g.InvalidateBankCode(i, func(bc string) bool {
+			_, ok := s.bicsRepo.Bank(cc, bc)
+			return ok
+		})
+	case d == iban.DefectNationalCheckDigit && cc == iban.CountryCodeDE:
+		if method == "" {
+			return "", errors.New("a bic or a known bank code is needed to break the check digit of a German account number")
+		}
+		return g.InvalidateAccountNo(i, method)
+	}
+	return g.Invalidate(i, d)
+}
+
+// defaultEnumerateLimit is the number of ibans of a page if no limit is given.
+const defaultEnumerateLimit = 100
+
+// maxEnumerateMisses is the maximum number of scans of the Enumerator without an iban for one page.
+const maxEnumerateMisses = 10
+
+// enumerateCursor is the state of an enumeration that is encoded in a cursor.
+type enumerateCursor struct {
+	Params   v1.EnumerateParams `json:"p"`
+	Position string             `json:"c"`
+}
 
+// enumerate returns the ibans of a bank page by page.
+func (s *server) enumerate(w http.ResponseWriter, r *http.Request, params v1.EnumerateParams) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := defaultEnumerateLimit
+		if params.Limit != nil {
+			limit = *params.Limit
+		}
+		if limit < 1 || limit > maxRandomBatch {
+			s.httpError(w, fmt.Sprintf("limit must be between 1 and %d", maxRandomBatch), http.StatusBadRequest)
+			return
+		}
+		state := enumerateCursor{Params: params}
+		if params.Cursor != nil && *params.Cursor != "" {
+			state = enumerateCursor{}
+			if _, err := s.decodeReplay("enumerate", *params.Cursor, &state); err != nil {
+				s.httpError(w, err.Error(), replayStatus(err))
+				return
 			}
-		} else {
-			i, err = iban.GenerateForCountry(iban.CountryCodeDE)
+		}
+		state.Params.Limit, state.Params.Cursor = nil, nil
+		cc, bc, method, code, err := s.criteria(v1.RandomParams{
+			Bic:         state.Params.Bic,
+			BankCode:    state.Params.BankCode,
+			CountryCode: state.Params.CountryCode,
+		})
+		if err != nil {
+			s.httpError(w, err.Error(), code)
+			return
+		}
+		if bc == "" {
+			s.httpError(w, "a bic or a bank code is required", http.StatusBadRequest)
+			return
+		}
+		if state.Params.SkipInvalid == nil || !*state.Params.SkipInvalid {
+			method = ""
+		}
+		e, err := iban.NewEnumerator(cc, bc, method)
+		if errors.Is(err, iban.ErrUnsupportedMethod) {
+			s.httpError(w, err.Error(), http.StatusNotImplemented)
+			return
+		}
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := e.Seek(state.Position); err != nil {
+			s.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res := v1.IBANPage{
+			Ibans: make([]string, 0, limit),
+		}
+		// Sparse check methods can leave a page with fewer ibans than the limit;
+		// the cursor continues where the scan stopped.
+		for misses := 0; len(res.Ibans) < limit && !e.Done() && misses < maxEnumerateMisses; {
+			if err := r.Context().Err(); err != nil {
+				level.Debug(s.logger).Log("msg", "enumeration was cancelled", "err", err.Error())
+				return
+			}
+			i, ok := e.Next()
+			if !ok {
+				misses++
+				continue
+			}
+			res.Ibans = append(res.Ibans, i.String())
+		}
+		if state.Position = e.Cursor(); state.Position != "" {
+			c, err := s.encodeReplay("enumerate", 0, state)
 			if err != nil {
 				s.httpError(w, err.Error(), http.StatusInternalServerError)
 				return
 			}
+			res.Cursor = &c
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// edgecases returns edge case ibans of a country.
+func (s *server) edgecases(w http.ResponseWriter, r *http.Request, params v1.EdgecasesParams) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cc := normalizeCountryCode(params.CountryCode)
+		ecs, err := iban.EdgeCases(cc, s.bicsRepo.BankCodes(cc))
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res := make([]v1.EdgeCase, len(ecs))
+		for i, ec := range ecs {
+			res[i] = v1.EdgeCase{
+				Name:        v1.EdgeCaseName(ec.Name),
+				Description: ec.Description,
+				Iban:        ec.IBAN.String(),
+				Bankcode:    ec.IBAN.BankCode(),
+			}
+			if b, ok := s.bicsRepo.Bank(cc, ec.IBAN.BankCode()); ok && b.BIC != "" {
+				res[i].Bic = &b.BIC
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// typos returns typo variants of an iban.
+func (s *server) typos(w http.ResponseWriter, r *http.Request, params v1.TyposParams) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		i, err := iban.ParseLenient(params.Iban)
+		if err != nil {
+			s.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		ts := iban.Typos(i)
+		res := make([]v1.Typo, 0, len(ts))
+		for _, t := range ts {
+			if params.Kind != nil && string(*params.Kind) != string(t.Kind) {
+				continue
+			}
+			res = append(res, v1.Typo{
+				Kind:     v1.TypoKind(t.Kind),
+				Position: t.Position,
+				Variant:  t.Variant,
+				Caught:   t.Caught,
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// maxValidateBatch is the maximum number of ibans that can be validated in one request.
+const maxValidateBatch = 1000
+
+// validate validates an iban.
+func (s *server) validate(w http.ResponseWriter, r *http.Request, params v1.ValidateParams) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res := s.validation(params.Iban)
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
 		}
+	}
+}
 
-		res := v1.IBANGeneration{
-			Bankcode: i.BankCode(),
-			Iban:     i.String(),
-			Bic:      params.Bic,
+// validateBatch validates multiple ibans.
+func (s *server) validateBatch(w http.ResponseWriter, r *http.Request) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body v1.ValidateBatchJSONBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			s.httpError(w, fmt.Sprintf("failed to decode body: %v", err), http.StatusBadRequest)
+			return
+		}
+		if len(body) > maxValidateBatch {
+			s.httpError(w, fmt.Sprintf("at most %d ibans can be validated at once", maxValidateBatch), http.StatusBadRequest)
+			return
+		}
+		res := make([]v1.IBANValidation, len(body))
+		for i, in := range body {
+			res[i] = s.validation(in)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// validation validates an iban and looks up its bank.
+func (s *server) validation(in string) v1.IBANValidation {
+	res := v1.IBANValidation{
+		Iban:       in,
+		Normalized: iban.Normalize(in),
+	}
+	i, err := iban.Parse(res.Normalized)
+	if err != nil {
+		reason := v1.IBANValidationReason(iban.ReasonOf(err))
+		msg := err.Error()
+		res.Reason = &reason
+		res.Error = &msg
+		if cs := s.suggestions(res.Normalized); len(cs) > 0 {
+			res.Suggestions = &cs
+		}
+		return res
+	}
+	cc, bc := i.CountryCode(), i.BankCode()
+	res.Valid = true
+	res.CountryCode = &cc
+	res.Bankcode = &bc
+	if b, ok := s.bicsRepo.Bank(iban.CountryCode(cc), bc); ok {
+		res.Bank = &b.Bank
+		if b.BIC != "" {
+			res.Bic = &b.BIC
+		}
+	}
+	return res
+}
+
+// suggestions returns the candidates for an invalid iban.
+func (s *server) suggestions(in string) []v1.Suggestion {
+	cs := iban.SuggestWithBanks(in, func(cc iban.CountryCode, bc string) bool {
+		_, ok := s.bicsRepo.Bank(cc, bc)
+		return ok
+	})
+	res := make([]v1.Suggestion, len(cs))
+	for i, c := range cs {
+		res[i] = v1.Suggestion{
+			Iban:     c.IBAN.String(),
+			Kind:     v1.SuggestionKind(c.Kind),
+			Position: c.Position,
+		}
+		if b, ok := s.bicsRepo.Bank(iban.CountryCode(c.IBAN.CountryCode()), c.IBAN.BankCode()); ok {
+			res[i].Bank = &b.Bank
+		}
+	}
+	return res
+}
+
+// convert converts a German account to an iban.
+func (s *server) convert(w http.ResponseWriter, r *http.Request, params v1.ConvertParams) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, ok := s.bicsRepo.Bank(iban.CountryCodeDE, params.BankCode)
+		if !ok {
+			s.httpError(w, "unknown bank code", http.StatusNotFound)
+			return
+		}
+		rule := b.IBANRule
+		i, err := iban.ConvertLegacy(params.BankCode, params.AccountNo, rule)
+		switch {
+		case errors.Is(err, iban.ErrNoIBAN):
+			s.httpError(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		case errors.Is(err, iban.ErrUnknownRule):
+			s.httpError(w, fmt.Sprintf("%v: the bank data has no IBAN rule column", err), http.StatusNotImplemented)
+			return
+		case errors.Is(err, iban.ErrUnsupportedRule):
+			s.httpError(w, err.Error(), http.StatusNotImplemented)
+			return
+		case err != nil:
+			s.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		res := v1.IBANConversion{
+			Iban:      i.String(),
+			Bankcode:  i.BankCode(),
+			AccountNo: i.AccountNo(),
+			Rule:      rule,
+		}
+		if nb, ok := s.bicsRepo.Bank(iban.CountryCodeDE, i.BankCode()); ok && nb.BIC != "" {
+			res.Bic = &nb.BIC
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(res); err != nil {
+			s.httpError(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// kontocheck checks a German account number.
+func (s *server) kontocheck(w http.ResponseWriter, r *http.Request, params v1.KontocheckParams) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c := s.checker.Check(params.BankCode, params.AccountNo)
+		res := v1.KontoCheck{
+			Bankcode:  c.BankCode,
+			AccountNo: c.AccountNo,
+			Valid:     c.Valid,
+		}
+		if c.Method != "" {
+			res.Method = &c.Method
+		}
+		if c.Err != nil {
+			reason := v1.KontoCheckReason(c.Reason)
+			msg := c.Err.Error()
+			res.Reason = &reason
+			res.Error = &msg
 		}
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(res); err != nil {
@@ -154,8 +800,11 @@ func (s *server) bics(w http.ResponseWriter, r *http.Request, params v1.BicsPara
 // countryCodes returns all countryCodes.
 func (s *server) countryCodes(w http.ResponseWriter, r *http.Request) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
-		res := make([]string, 1)
-		res[0] = string(iban.CountryCodeDE)
+		ccs := iban.CountryCodes()
+		res := make([]string, len(ccs))
+		for i, cc := range ccs {
+			res[i] = string(cc)
+		}
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(res); err != nil {
 			s.httpError(w, err.Error(), http.StatusInternalServerError)
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-kit/kit/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	v1 "github.com/leonnicolas/iban-gen/api/v1"
+	"github.com/leonnicolas/iban-gen/bic"
+)
+
+const bundesbankFile = "../cmd/iban-gen/data/bundesbank.txt"
+
+// newTestServer returns a test server with the bank data of the first n records of the embedded Bundesbank file
+// or all of them if n is negative and the IBAN rules that ship with iban-gen.
+func newTestServer(t *testing.T, n int) *httptest.Server {
+	b, err := os.ReadFile(bundesbankFile)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	lines := strings.SplitAfter(string(b), "\n")
+	if n >= 0 && n < len(lines) {
+		lines = lines[:n]
+	}
+	re := bic.NewBICRepo()
+	if _, err := re.Populate(strings.NewReader(strings.Join(lines, ""))); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if _, err := re.PopulateDefaultRules(); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	ts := httptest.NewServer(v1.Handler(NewInstrumentedServerWithLogger(re, prometheus.NewRegistry(), log.NewNopLogger())))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+// get requests the path with the query and returns the status code and body of the response.
+func get(t *testing.T, ts *httptest.Server, path string, q url.Values) (int, []byte) {
+	res, err := http.Get(ts.URL + path + "?" + q.Encode())
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	return res.StatusCode, body
+}
+
+func decode(t *testing.T, body []byte, v interface{}) {
+	if err := json.Unmarshal(body, v); err != nil {
+		t.Fatalf("got err=%q for %s\n", err.Error(), body)
+	}
+}
+
+func TestSeed(t *testing.T) {
+	ts := newTestServer(t, -1)
+	for _, tc := range []struct {
+		path string
+		q    url.Values
+	}{
+		{path: "/v1/random", q: url.Values{"seed": {"42"}}},
+		{path: "/v1/random", q: url.Values{"seed": {"42"}, "countryCode": {"GB"}, "invalid": {"checkDigits"}}},
+		{path: "/v1/random", q: url.Values{"seed": {"42"}, "bic": {"COBADEFFXXX"}, "format": {"all"}}},
+		{path: "/v1/randomBatch", q: url.Values{"seed": {"42"}, "count": {"20"}}},
+		{path: "/v1/randomBatch", q: url.Values{"seed": {"42"}, "count": {"20"}, "countryCode": {"NL"}}},
+	} {
+		code, first := get(t, ts, tc.path, tc.q)
+		if code != http.StatusOK {
+			t.Fatalf("%s?%s: got status=%d expected=%d: %s\n", tc.path, tc.q.Encode(), code, http.StatusOK, first)
+		}
+		if _, second := get(t, ts, tc.path, tc.q); string(first) != string(second) {
+			t.Errorf("%s?%s: got %s expected=%s\n", tc.path, tc.q.Encode(), second, first)
+		}
+	}
+	// Different seeds generate different ibans.
+	_, a := get(t, ts, "/v1/random", url.Values{"seed": {"1"}})
+	_, b := get(t, ts, "/v1/random", url.Values{"seed": {"2"}})
+	if string(a) == string(b) {
+		t.Errorf("got %s for different seeds\n", a)
+	}
+}
+
+func TestReplayRandom(t *testing.T) {
+	ts := newTestServer(t, -1)
+	for _, q := range []url.Values{
+		{},
+		{"countryCode": {"ch"}, "qrIban": {"true"}, "qrReference": {"true"}},
+		{"bankCode": {"37040044"}, "invalid": {"letters"}},
+		{"template": {"DE** 3704 0044 **** **** **"}},
+	} {
+		code, body := get(t, ts, "/v1/random", q)
+		if code != http.StatusOK {
+			t.Fatalf("%s: got status=%d expected=%d: %s\n", q.Encode(), code, http.StatusOK, body)
+		}
+		var g v1.IBANGeneration
+		decode(t, body, &g)
+		// The token replaces all other parameters.
+		code, replayed := get(t, ts, "/v1/random", url.Values{"replay": {g.ReplayToken}, "countryCode": {"FR"}})
+		if code != http.StatusOK || string(replayed) != string(body) {
+			t.Errorf("%s: got status=%d %s expected=%s\n", q.Encode(), code, replayed, body)
+		}
+	}
+}
+
+func TestReplayRandomBatch(t *testing.T) {
+	ts := newTestServer(t, -1)
+	code, body := get(t, ts, "/v1/randomBatch", url.Values{"count": {"10"}, "bic": {"COBADEFFXXX"}, "invalid": {"checkDigits"}})
+	if code != http.StatusOK {
+		t.Fatalf("got status=%d expected=%d: %s\n", code, http.StatusOK, body)
+	}
+	var gs []v1.IBANGeneration
+	decode(t, body, &gs)
+	if len(gs) != 10 {
+		t.Fatalf("got %d ibans expected=%d\n", len(gs), 10)
+	}
+	// Every iban of a batch is reproduced by its token with /v1/random.
+	for _, g := range gs {
+		code, body := get(t, ts, "/v1/random", url.Values{"replay": {g.ReplayToken}})
+		var replayed v1.IBANGeneration
+		decode(t, body, &replayed)
+		if code != http.StatusOK || replayed.Iban != g.Iban || replayed.ReplayToken != g.ReplayToken {
+			t.Errorf("got status=%d %+v expected=%+v\n", code, replayed, g)
+		}
+	}
+}
+
+func TestReplayEnumerate(t *testing.T) {
+	ts := newTestServer(t, -1)
+	q := url.Values{"bankCode": {"37040044"}, "limit": {"5"}, "skipInvalid": {"true"}}
+	var pages []v1.IBANPage
+	for i := 0; i < 3; i++ {
+		code, body := get(t, ts, "/v1/enumerate", q)
+		if code != http.StatusOK {
+			t.Fatalf("got status=%d expected=%d: %s\n", code, http.StatusOK, body)
+		}
+		var p v1.IBANPage
+		decode(t, body, &p)
+		if p.Cursor == nil {
+			t.Fatalf("got no cursor for page %d\n", i)
+		}
+		// A cursor returns the same page every time and replaces all other parameters.
+		code, again := get(t, ts, "/v1/enumerate", url.Values{"cursor": q["cursor"], "limit": {"5"}, "bankCode": {"10000000"}})
+		if i > 0 && (code != http.StatusOK || string(again) != string(body)) {
+			t.Errorf("page %d: got status=%d %s expected=%s\n", i, code, again, body)
+		}
+		pages = append(pages, p)
+		q = url.Values{"cursor": {*p.Cursor}, "limit": {"5"}}
+	}
+	seen := make(map[string]bool)
+	for _, p := range pages {
+		if len(p.Ibans) != 5 {
+			t.Errorf("got %d ibans expected=%d\n", len(p.Ibans), 5)
+		}
+		for _, i := range p.Ibans {
+			if seen[i] {
+				t.Errorf("got %s twice\n", i)
+			}
+			seen[i] = true
+		}
+	}
+}
+
+func TestReplayStale(t *testing.T) {
+	ts := newTestServer(t, -1)
+	// Other bank data has another data version.
+	other := newTestServer(t, 100)
+	_, body := get(t, ts, "/v1/random", url.Values{"countryCode": {"GB"}})
+	var g v1.IBANGeneration
+	decode(t, body, &g)
+	if code, body := get(t, other, "/v1/random", url.Values{"replay": {g.ReplayToken}}); code != http.StatusConflict {
+		t.Errorf("got status=%d expected=%d: %s\n", code, http.StatusConflict, body)
+	}
+	_, body = get(t, ts, "/v1/enumerate", url.Values{"bankCode": {"37040044"}, "limit": {"1"}})
+	var p v1.IBANPage
+	decode(t, body, &p)
+	if p.Cursor == nil {
+		t.Fatal("got no cursor\n")
+	}
+	if code, body := get(t, other, "/v1/enumerate", url.Values{"cursor": {*p.Cursor}}); code != http.StatusConflict {
+		t.Errorf("got status=%d expected=%d: %s\n", code, http.StatusConflict, body)
+	}
+}
+
+func TestReplayMalformed(t *testing.T) {
+	ts := newTestServer(t, 100)
+	_, body := get(t, ts, "/v1/random", url.Values{"countryCode": {"GB"}})
+	var g v1.IBANGeneration
+	decode(t, body, &g)
+	_, body = get(t, ts, "/v1/enumerate", url.Values{"bankCode": {"10000000"}, "limit": {"1"}})
+	var p v1.IBANPage
+	decode(t, body, &p)
+	if p.Cursor == nil {
+		t.Fatal("got no cursor\n")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(g.ReplayToken)
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	var tok replayToken
+	decode(t, raw, &tok)
+	tamper := func(f func(*replayToken)) string {
+		t := tok
+		f(&t)
+		b, _ := json.Marshal(t)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	for _, tc := range []struct {
+		name string
+		path string
+		q    url.Values
+		code int
+	}{
+		{name: "not base64", path: "/v1/random", q: url.Values{"replay": {"not a token!"}}, code: http.StatusBadRequest},
+		{name: "not json", path: "/v1/random", q: url.Values{"replay": {base64.RawURLEncoding.EncodeToString([]byte("{"))}}, code: http.StatusBadRequest},
+		{name: "truncated", path: "/v1/random", q: url.Values{"replay": {g.ReplayToken[:len(g.ReplayToken)/2]}}, code: http.StatusBadRequest},
+		{name: "cursor as token", path: "/v1/random", q: url.Values{"replay": {*p.Cursor}}, code: http.StatusBadRequest},
+		{name: "token as cursor", path: "/v1/enumerate", q: url.Values{"cursor": {g.ReplayToken}}, code: http.StatusBadRequest},
+		{name: "other op", path: "/v1/random", q: url.Values{"replay": {tamper(func(t *replayToken) { t.Op = "randomBatch" })}}, code: http.StatusBadRequest},
+		{name: "bad params", path: "/v1/random", q: url.Values{"replay": {tamper(func(t *replayToken) { t.Params = json.RawMessage(`"x"`) })}}, code: http.StatusBadRequest},
+		{name: "other version", path: "/v1/random", q: url.Values{"replay": {tamper(func(t *replayToken) { t.Version = "0000000000000000" })}}, code: http.StatusConflict},
+	} {
+		code, body := get(t, ts, tc.path, tc.q)
+		if code != tc.code {
+			t.Errorf("%s: got status=%d expected=%d: %s\n", tc.name, code, tc.code, body)
+			continue
+		}
+		var e v1.Error
+		decode(t, body, &e)
+		if e.Error == "" {
+			t.Errorf("%s: got no error message\n", tc.name)
+		}
+	}
+	// A valid token with another seed is just another generation.
+	code, body := get(t, ts, "/v1/random", url.Values{"replay": {tamper(func(t *replayToken) { t.Seed++ })}})
+	var replayed v1.IBANGeneration
+	decode(t, body, &replayed)
+	if code != http.StatusOK || !strings.HasPrefix(replayed.Iban, "GB") {
+		t.Errorf("got status=%d %s\n", code, body)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	ts := newTestServer(t, -1)
+	for _, tc := range []struct {
+		bc   string
+		aNo  string
+		code int
+		out  string
+		rule string
+	}{
+		{bc: "37040044", aNo: "532013000", code: http.StatusOK, out: "DE89370400440532013000", rule: "000000"},
+		{bc: "10050000", aNo: "135", code: http.StatusOK, out: "DE86100500000990021440", rule: "000400"},
+		{bc: "10070024", aNo: "1234567", code: http.StatusOK, out: "DE10100700240123456700", rule: "002000"},
+		{bc: "66050000", aNo: "532013000", code: http.StatusOK, out: "DE78600501010532013000", rule: "002500"},
+		{bc: "10070000", aNo: "1234586000", code: http.StatusUnprocessableEntity},
+		{bc: "37040044", aNo: "12345678901", code: http.StatusBadRequest},
+		{bc: "99999999", aNo: "532013000", code: http.StatusNotFound},
+	} {
+		code, body := get(t, ts, "/v1/convert", url.Values{"bankCode": {tc.bc}, "accountNo": {tc.aNo}})
+		if code != tc.code {
+			t.Errorf("%s %s: got status=%d expected=%d: %s\n", tc.bc, tc.aNo, code, tc.code, body)
+			continue
+		}
+		if code != http.StatusOK {
+			continue
+		}
+		var c v1.IBANConversion
+		decode(t, body, &c)
+		if c.Iban != tc.out || c.Rule != tc.rule {
+			t.Errorf("%s %s: got %+v expected iban %s and rule %s\n", tc.bc, tc.aNo, c, tc.out, tc.rule)
+		}
+	}
+}
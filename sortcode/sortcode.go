@@ -0,0 +1,227 @@
+// Package sortcode checks UK sort codes and account numbers with the
+// modulus checking rules published by Vocalink.
+package sortcode
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Methods of the modulus checking rules.
+const (
+	MethodMod10 = "MOD10"
+	MethodMod11 = "MOD11"
+	MethodDblAl = "DBLAL"
+)
+
+var (
+	// ErrMalformed is returned for sort codes that are not six digits and
+	// account numbers that are not eight digits.
+	ErrMalformed = errors.New("sort code must be six and account number eight digits")
+	// ErrModulus is returned for account numbers that fail the modulus check of their sort code.
+	ErrModulus = errors.New("account number failed the modulus check")
+)
+
+// Rule is a line of the Vocalink sort code weight table.
+type Rule struct {
+	// From and To is the range of sort codes the rule applies to.
+	From, To int
+	Method   string
+	// Weights are applied to the six digits of the sort code followed by the
+	// eight digits of the account number.
+	Weights [14]int
+	// Exception is the exception number of the rule or 0.
+	Exception int
+}
+
+// Table contains the modulus checking rules of sort codes.
+type Table struct {
+	rules []Rule
+	// hash is the checksum of all populated rules.
+	hash hash.Hash
+}
+
+// NewTable returns a new Table.
+func NewTable() *Table {
+	return &Table{}
+}
+
+// Rules returns all rules of the Table.
+func (t *Table) Rules() []Rule {
+	return t.rules
+}
+
+// Version returns a version of the Table that changes with its rules.
+// It is empty for a Table without rules.
+func (t *Table) Version() string {
+	if t.hash == nil {
+		return ""
+	}
+	return hex.EncodeToString(t.hash.Sum(nil)[:8])
+}
+
+// PopulateFromFile populates the Table from a file.
+func (t *Table) PopulateFromFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return t.Populate(f)
+}
+
+// Populate populates the Table from an io.Reader in the format of the
+// Vocalink valacdos.txt file.
+func (t *Table) Populate(r io.Reader) (int, error) {
+	if t.hash == nil {
+		t.hash = sha256.New()
+	}
+	s := bufio.NewScanner(r)
+	c := 0
+	for s.Scan() {
+		fs := strings.Fields(s.Text())
+		if len(fs) == 0 {
+			continue
+		}
+		t.hash.Write([]byte(strings.Join(fs, " ") + "\n"))
+		if len(fs) != 17 && len(fs) != 18 {
+			return c, fmt.Errorf("invalid entry %q", s.Text())
+		}
+		var ru Rule
+		var err error
+		if ru.From, err = strconv.Atoi(fs[0]); err != nil {
+			return c, fmt.Errorf("invalid entry %q: %w", s.Text(), err)
+		}
+		if ru.To, err = strconv.Atoi(fs[1]); err != nil {
+			return c, fmt.Errorf("invalid entry %q: %w", s.Text(), err)
+		}
+		ru.Method = fs[2]
+		switch ru.Method {
+		case MethodMod10, MethodMod11, MethodDblAl:
+		default:
+			return c, fmt.Errorf("invalid method %q", ru.Method)
+		}
+		for i := range ru.Weights {
+			if ru.Weights[i], err = strconv.Atoi(fs[3+i]); err != nil {
+				return c, fmt.Errorf("invalid entry %q: %w", s.Text(), err)
+			}
+		}
+		if len(fs) == 18 {
+			if ru.Exception, err = strconv.Atoi(fs[17]); err != nil {
+				return c, fmt.Errorf("invalid entry %q: %w", s.Text(), err)
+			}
+		}
+		t.rules = append(t.rules, ru)
+		c++
+	}
+	return c, s.Err()
+}
+
+// supportedExceptions contains the exceptions of the modulus checking rules that are implemented.
+var supportedExceptions = map[int]bool{0: true, 1: true, 3: true, 4: true, 6: true, 7: true, 8: true, 10: true, 12: true, 13: true}
+
+// Check checks the account number with the rules of the sort code.
+// Sort codes without rules cannot be checked and are considered valid.
+// The exceptions 1, 3, 4, 6, 7, 8, 10, 12 and 13 are supported.
+// Sort codes with rules with other exceptions, e.g. 2 and 9 or 5, are not checked and considered valid,
+// because checking them without their exception would reject valid account numbers.
+func (t *Table) Check(sortCode, accountNo string) error {
+	sc, err := strconv.Atoi(sortCode)
+	if err != nil || len(sortCode) != 6 || len(accountNo) != 8 {
+		return ErrMalformed
+	}
+	if _, err := strconv.Atoi(accountNo); err != nil {
+		return ErrMalformed
+	}
+	var ds [14]int
+	for i, c := range sortCode + accountNo {
+		ds[i] = int(c - '0')
+	}
+	rules := make([]Rule, 0, 2)
+	for _, ru := range t.rules {
+		if sc >= ru.From && sc <= ru.To {
+			rules = append(rules, ru)
+		}
+	}
+	for _, ru := range rules {
+		if !supportedExceptions[ru.Exception] {
+			return nil
+		}
+	}
+	const a, b, c, g, h = 6, 7, 8, 12, 13
+	for i, ru := range rules {
+		switch {
+		case ru.Exception == 6 && ds[a] >= 4 && ds[a] <= 8 && ds[g] == ds[h]:
+			// Foreign currency accounts cannot be checked.
+			return nil
+		case ru.Exception == 3 && i > 0 && (ds[c] == 6 || ds[c] == 9):
+			continue
+		}
+		if ru.check(ds) {
+			if ru.Exception == 12 {
+				return nil
+			}
+			continue
+		}
+		if ru.Exception == 12 && i+1 < len(rules) {
+			continue
+		}
+		return fmt.Errorf("%w %s for sort code %s", ErrModulus, ru.Method, sortCode)
+	}
+	return nil
+}
+
+func (ru Rule) check(ds [14]int) bool {
+	const a, b, g, h = 6, 7, 12, 13
+	w := ru.Weights
+	switch ru.Exception {
+	case 7:
+		if ds[g] == 9 {
+			w = zeroise(w)
+		}
+	case 8:
+		copy(ds[:6], []int{0, 9, 0, 1, 2, 6})
+	case 10:
+		if (ds[a] == 0 || ds[a] == 9) && ds[b] == 9 && ds[g] == 9 {
+			w = zeroise(w)
+		}
+	}
+	sum := 0
+	for i := range ds {
+		p := ds[i] * w[i]
+		if ru.Method == MethodDblAl {
+			p = p/10 + p%10
+		}
+		sum += p
+	}
+	switch ru.Method {
+	case MethodMod10:
+		return sum%10 == 0
+	case MethodMod11:
+		if ru.Exception == 4 {
+			return sum%11 == ds[g]*10+ds[h]
+		}
+		return sum%11 == 0
+	default:
+		if ru.Exception == 1 {
+			sum += 27
+		}
+		return sum%10 == 0
+	}
+}
+
+// zeroise sets the weights of the sort code and the first two digits of the account number to 0.
+func zeroise(w [14]int) [14]int {
+	for i := 0; i < 8; i++ {
+		w[i] = 0
+	}
+	return w
+}
@@ -0,0 +1,65 @@
+package sortcode
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const table = `089000 089999 MOD10    0    0    0    0    0    0    7    1    3    7    1    3    7    1
+107000 107999 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1
+202900 202999 MOD11    0    0    0    0    0    0    0    7    6    5    4    3    2    1
+202900 202999 DBLAL    2    1    2    1    2    1    2    1    2    1    2    1    2    1
+300000 300999 MOD10    0    0    0    0    0    0    7    1    3    7    1    3    7    1   12
+300000 300999 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1   13
+309000 309999 MOD11    0    0    1    2    5    3    6    4    8    7   10    9    3    1    2
+309000 309999 DBLAL    2    1    2    1    2    1    2    1    2    1    2    1    2    1    9
+`
+
+func TestCheck(t *testing.T) {
+	tb := NewTable()
+	n, err := tb.Populate(strings.NewReader(table))
+	if err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if n != 8 {
+		t.Errorf("got %d rules, expected %d\n", n, 8)
+	}
+	if v := NewTable().Version(); v != "" {
+		t.Errorf("got version %q for an empty table\n", v)
+	}
+	other := NewTable()
+	if _, err := other.Populate(strings.NewReader(table[:strings.IndexByte(table, '\n')+1])); err != nil {
+		t.Fatalf("got err=%q\n", err.Error())
+	}
+	if tb.Version() == "" || tb.Version() == other.Version() {
+		t.Errorf("got versions %q and %q for different tables\n", tb.Version(), other.Version())
+	}
+	for _, tc := range []struct {
+		sc  string
+		aNo string
+		err error
+	}{
+		{sc: "089999", aNo: "66374958"},
+		{sc: "089999", aNo: "66374959", err: ErrModulus},
+		{sc: "107999", aNo: "88837491"},
+		{sc: "107999", aNo: "88837492", err: ErrModulus},
+		{sc: "202959", aNo: "63748472"},
+		{sc: "202959", aNo: "63748473", err: ErrModulus},
+		// Passes the first check.
+		{sc: "300000", aNo: "66374958"},
+		// Fails the first but passes the second check.
+		{sc: "300000", aNo: "88837491"},
+		{sc: "300000", aNo: "88837492", err: ErrModulus},
+		// Sort codes with unsupported exceptions are not checked.
+		{sc: "309500", aNo: "12345678"},
+		// Sort codes without rules are not checked.
+		{sc: "400000", aNo: "12345678"},
+		{sc: "40000", aNo: "12345678", err: ErrMalformed},
+		{sc: "400000", aNo: "1234567A", err: ErrMalformed},
+	} {
+		if err := tb.Check(tc.sc, tc.aNo); !errors.Is(err, tc.err) {
+			t.Errorf("%s %s: got err=%v expected=%v\n", tc.sc, tc.aNo, err, tc.err)
+		}
+	}
+}